@@ -28,6 +28,7 @@ require (
 
 require (
 	github.com/creasty/defaults v1.8.0
+	github.com/docker/go-units v0.5.0
 	github.com/elk-language/go-prompt v1.1.5
 	github.com/golang/protobuf v1.5.4
 	github.com/vbauerster/mpb/v8 v8.10.2
@@ -44,7 +45,6 @@ require (
 	github.com/containerd/errdefs/pkg v0.3.0 // indirect
 	github.com/containerd/log v0.1.0 // indirect
 	github.com/distribution/reference v0.6.0 // indirect
-	github.com/docker/go-units v0.5.0 // indirect
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
@@ -0,0 +1,461 @@
+package clab
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mroy31/gonetem/internal/options"
+	"github.com/mroy31/gonetem/internal/topology"
+	"github.com/mroy31/gonetem/internal/utils"
+	"gopkg.in/yaml.v3"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	networkFilename = "network.yml"
+	configDir       = "configs"
+)
+
+var (
+	invalidNameRE = regexp.MustCompile(`\W`)
+	ifIndexRE     = regexp.MustCompile(`(\d+)$`)
+)
+
+// ClabNode is the subset of a containerlab node definition
+// understood by gonetem
+type ClabNode struct {
+	Kind          string   `yaml:",omitempty"`
+	Image         string   `yaml:",omitempty"`
+	StartupConfig string   `yaml:"startup-config,omitempty"`
+	Binds         []string `yaml:",omitempty"`
+	Exec          []string `yaml:",omitempty"`
+}
+
+type ClabLink struct {
+	Endpoints []string
+}
+
+type ClabTopology struct {
+	Name     string
+	Topology struct {
+		Defaults ClabNode            `yaml:",omitempty"`
+		Kinds    map[string]ClabNode `yaml:",omitempty"`
+		Nodes    map[string]ClabNode `yaml:",omitempty"`
+		Links    []ClabLink          `yaml:",omitempty"`
+	}
+}
+
+// ImportResult contains the gonetem topology built from a containerlab
+// file with the configuration files to add in the project
+type ImportResult struct {
+	Topology   topology.NetemTopology
+	Configs    map[string][]byte
+	ExtraNodes []options.DockerNodeConfig
+	Warnings   []string
+}
+
+func getDefaultNodeConfigs() (*options.NetemServerConfig, error) {
+	var config options.NetemServerConfig
+	if err := sigsyaml.Unmarshal([]byte(options.INITIAL_SERVER_CONFIG), &config); err != nil {
+		return nil, fmt.Errorf("unable to parse default server config: %w", err)
+	}
+	return &config, nil
+}
+
+// imageBaseName returns the name of an image without registry and tag
+func imageBaseName(image string) string {
+	name := image[strings.LastIndex(image, "/")+1:]
+	if idx := strings.Index(name, ":"); idx >= 0 {
+		name = name[:idx]
+	}
+	return name
+}
+
+func sanitizeName(name string) string {
+	return invalidNameRE.ReplaceAllString(name, "_")
+}
+
+// getNodeType maps a containerlab kind/image on a gonetem node type. When
+// no builtin type matches, an extra node config is returned, it has to be
+// declared in the server configuration
+func getNodeType(kind, image string) (string, *options.DockerNodeConfig) {
+	switch kind {
+	case "bridge", "ovs-bridge":
+		return "ovs", nil
+	}
+
+	baseName := imageBaseName(image)
+	switch {
+	case baseName == "gonetem-host":
+		return "docker.host", nil
+	case baseName == "gonetem-server":
+		return "docker.server", nil
+	case baseName == "gonetem-bmv2":
+		return "docker.p4sw", nil
+	case strings.Contains(baseName, "frr"):
+		return "docker.router", nil
+	}
+
+	nType := sanitizeName(strings.ToLower(kind))
+	if kind == "linux" && baseName != "" {
+		nType = sanitizeName(strings.ToLower(baseName))
+	}
+
+	extra := &options.DockerNodeConfig{
+		Type:  nType,
+		Image: image,
+	}
+	extra.Commands.Console = "/bin/sh"
+	extra.Commands.Shell = "/bin/sh"
+
+	return "docker." + nType, extra
+}
+
+func parseEndpoint(endpoint string, names map[string]string) (string, error) {
+	split := strings.SplitN(endpoint, ":", 2)
+	if len(split) != 2 {
+		return "", fmt.Errorf("endpoint '%s' is not valid (<node>:<interface> required)", endpoint)
+	}
+
+	name, found := names[split[0]]
+	if !found {
+		return "", fmt.Errorf("endpoint '%s': node %s not found", endpoint, split[0])
+	}
+
+	groups := ifIndexRE.FindStringSubmatch(split[1])
+	if len(groups) != 2 {
+		return "", fmt.Errorf("endpoint '%s': unable to get interface index", endpoint)
+	}
+
+	return fmt.Sprintf("%s.%s", name, groups[1]), nil
+}
+
+// Import converts the containerlab topology stored in clabPath
+func Import(clabPath string) (*ImportResult, error) {
+	data, err := os.ReadFile(clabPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read containerlab file '%s': %w", clabPath, err)
+	}
+
+	var clab ClabTopology
+	if err := yaml.Unmarshal(data, &clab); err != nil {
+		return nil, fmt.Errorf("unable to parse containerlab file '%s': %w", clabPath, err)
+	}
+
+	result := &ImportResult{
+		Topology: topology.NetemTopology{
+			Nodes: make(map[string]topology.NodeConfig),
+		},
+		Configs: make(map[string][]byte),
+	}
+	clabDir := filepath.Dir(clabPath)
+	extraTypes := make(map[string]bool)
+
+	// sort nodes to get a deterministic result
+	clabNames := make([]string, 0, len(clab.Topology.Nodes))
+	for name := range clab.Topology.Nodes {
+		clabNames = append(clabNames, name)
+	}
+	sort.Strings(clabNames)
+
+	names := make(map[string]string)
+	for _, clabName := range clabNames {
+		node := clab.Topology.Nodes[clabName]
+		if node.Kind == "" {
+			node.Kind = clab.Topology.Defaults.Kind
+		}
+		kindDefaults := clab.Topology.Kinds[node.Kind]
+		if node.Image == "" {
+			node.Image = kindDefaults.Image
+		}
+		if node.Image == "" {
+			node.Image = clab.Topology.Defaults.Image
+		}
+		if node.StartupConfig == "" {
+			node.StartupConfig = kindDefaults.StartupConfig
+		}
+
+		name := sanitizeName(clabName)
+		if _, found := result.Topology.Nodes[name]; found {
+			return nil, fmt.Errorf("node %s: name conflicts with another node once converted", clabName)
+		}
+		names[clabName] = name
+
+		nType, extra := getNodeType(node.Kind, node.Image)
+		if extra != nil && !extraTypes[extra.Type] {
+			extraTypes[extra.Type] = true
+			result.ExtraNodes = append(result.ExtraNodes, *extra)
+		}
+		if nType == "ovs" && len(name) > 10 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"switch %s: name must have less than 10 caracters, rename it in the topology", name))
+		}
+
+		nConfig := topology.NodeConfig{
+			Type:   nType,
+			IPv6:   true,
+			Launch: true,
+		}
+		for _, bind := range node.Binds {
+			split := strings.SplitN(bind, ":", 2)
+			if len(split) == 2 && !filepath.IsAbs(split[0]) {
+				bind = filepath.Join(clabDir, split[0]) + ":" + split[1]
+			}
+			nConfig.Volumes = append(nConfig.Volumes, bind)
+		}
+		result.Topology.Nodes[name] = nConfig
+
+		// startup config
+		if node.StartupConfig != "" {
+			confPath := node.StartupConfig
+			if !filepath.IsAbs(confPath) {
+				confPath = filepath.Join(clabDir, confPath)
+			}
+
+			if nType == "docker.router" {
+				data, err := os.ReadFile(confPath)
+				if err != nil {
+					return nil, fmt.Errorf("node %s: unable to read startup-config: %w", clabName, err)
+				}
+				result.Configs[name+".frr.conf"] = data
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf(
+					"node %s: startup-config is not supported for %s nodes", clabName, nType))
+			}
+		}
+
+		// exec commands
+		if len(node.Exec) > 0 {
+			if nType == "docker.host" || nType == "docker.server" {
+				result.Configs[name+".init.conf"] = []byte("#!/bin/bash\n\n" + strings.Join(node.Exec, "\n") + "\n")
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf(
+					"node %s: exec commands are not supported for %s nodes", clabName, nType))
+			}
+		}
+	}
+
+	for _, l := range clab.Topology.Links {
+		if len(l.Endpoints) != 2 {
+			return nil, fmt.Errorf("link %v: 2 endpoints are required", l.Endpoints)
+		}
+
+		peer1, err := parseEndpoint(l.Endpoints[0], names)
+		if err != nil {
+			return nil, err
+		}
+		peer2, err := parseEndpoint(l.Endpoints[1], names)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Topology.Links = append(result.Topology.Links, topology.LinkConfig{
+			Peer1: peer1,
+			Peer2: peer2,
+		})
+	}
+
+	return result, nil
+}
+
+func getPeerEndpoint(peer string) (string, error) {
+	split := strings.Split(peer, ".")
+	if len(split) != 2 {
+		return "", fmt.Errorf("peer '%s' is not valid", peer)
+	}
+	if _, err := strconv.Atoi(split[1]); err != nil {
+		return "", fmt.Errorf("peer '%s' is not valid", peer)
+	}
+
+	return fmt.Sprintf("%s:eth%s", split[0], split[1]), nil
+}
+
+// Export converts a gonetem topology to a containerlab one. configs
+// contains the startup-config path of each node, relative to the
+// containerlab file
+func Export(name string, topo *topology.NetemTopology, configs map[string]string) (*ClabTopology, []string, error) {
+	var warnings []string
+
+	defaultConfig, err := getDefaultNodeConfigs()
+	if err != nil {
+		return nil, warnings, err
+	}
+	images := map[string]string{
+		"docker.router": defaultConfig.Docker.Nodes.Router.Image,
+		"docker.host":   defaultConfig.Docker.Nodes.Host.Image,
+		"docker.server": defaultConfig.Docker.Nodes.Server.Image,
+		"docker.p4sw":   defaultConfig.Docker.Nodes.P4sw.Image,
+	}
+
+	clab := &ClabTopology{Name: name}
+	clab.Topology.Nodes = make(map[string]ClabNode)
+
+	for nName, nConfig := range topo.Nodes {
+		node := ClabNode{Kind: "linux", Binds: nConfig.Volumes}
+
+		switch {
+		case nConfig.Type == "ovs":
+			node = ClabNode{Kind: "bridge"}
+			warnings = append(warnings, fmt.Sprintf(
+				"switch %s: a bridge named %s has to be created on the host", nName, nName))
+		case nConfig.Image != "":
+			node.Image = nConfig.Image
+		case images[nConfig.Type] != "":
+			node.Image = options.GetDockerImageId(images[nConfig.Type])
+		default:
+			warnings = append(warnings, fmt.Sprintf(
+				"node %s: unable to find the image of type %s", nName, nConfig.Type))
+		}
+		node.StartupConfig = configs[nName]
+
+		clab.Topology.Nodes[nName] = node
+	}
+
+	for _, l := range topo.Links {
+		peer1, err := getPeerEndpoint(l.Peer1)
+		if err != nil {
+			return nil, warnings, err
+		}
+		peer2, err := getPeerEndpoint(l.Peer2)
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		if l.Profile != "" || l.Delay > 0 || l.Loss > 0 || l.Rate > 0 ||
+			!l.Peer1QoS.IsEmpty() || !l.Peer2QoS.IsEmpty() {
+			warnings = append(warnings, fmt.Sprintf(
+				"link %s-%s: QoS parameters are not exported", l.Peer1, l.Peer2))
+		}
+
+		clab.Topology.Links = append(clab.Topology.Links, ClabLink{
+			Endpoints: []string{peer1, peer2},
+		})
+	}
+
+	for bName := range topo.Bridges {
+		warnings = append(warnings, fmt.Sprintf("bridge %s: bridges are not exported", bName))
+	}
+
+	return clab, warnings, nil
+}
+
+// ImportProject creates the gonetem project prjPath from the containerlab
+// file clabPath
+func ImportProject(clabPath, prjPath string) (*ImportResult, error) {
+	result, err := Import(clabPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "gonetem-import-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp folder: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := yaml.Marshal(&result.Topology)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal yaml topo: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, networkFilename), data, 0644); err != nil {
+		return nil, fmt.Errorf("unable to write network file: %w", err)
+	}
+
+	if len(result.Configs) > 0 {
+		confDir := filepath.Join(dir, configDir)
+		if err := os.Mkdir(confDir, 0755); err != nil {
+			return nil, fmt.Errorf("unable to create configs dir %s: %w", confDir, err)
+		}
+		for filename, data := range result.Configs {
+			if err := os.WriteFile(filepath.Join(confDir, filename), data, 0644); err != nil {
+				return nil, fmt.Errorf("unable to write config file %s: %w", filename, err)
+			}
+		}
+	}
+
+	prj, err := os.Create(prjPath)
+	if err != nil {
+		return nil, err
+	}
+	defer prj.Close()
+
+	if err := utils.CreateArchive(dir, prj); err != nil {
+		return nil, fmt.Errorf("unable to create project archive: %w", err)
+	}
+
+	return result, nil
+}
+
+// ExportProject writes the containerlab file clabPath from the gonetem
+// project prjPath. Router configurations are copied in a folder next to
+// clabPath and used as startup-config
+func ExportProject(prjPath, clabPath string) ([]string, error) {
+	dir, err := os.MkdirTemp("", "gonetem-export-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp folder: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	f, err := os.Open(prjPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open project %s: %w", prjPath, err)
+	}
+	defer f.Close()
+
+	if err := utils.OpenArchive(dir, f); err != nil {
+		return nil, fmt.Errorf("unable to extract project %s: %w", prjPath, err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, networkFilename))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read topology file: %w", err)
+	}
+	var topo topology.NetemTopology
+	if err := yaml.Unmarshal(data, &topo); err != nil {
+		return nil, fmt.Errorf("unable to parse topology file: %w", err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(prjPath), ".gnet")
+	clabDir := filepath.Dir(clabPath)
+	configs := make(map[string]string)
+	for nName, nConfig := range topo.Nodes {
+		if nConfig.Type != "docker.router" {
+			continue
+		}
+
+		source := filepath.Join(dir, configDir, nName+".frr.conf")
+		data, err := os.ReadFile(source)
+		if err != nil {
+			continue
+		}
+
+		dest := filepath.Join(name, nName+".frr.conf")
+		if err := os.MkdirAll(filepath.Join(clabDir, name), 0755); err != nil {
+			return nil, fmt.Errorf("unable to create configs dir: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(clabDir, dest), data, 0644); err != nil {
+			return nil, fmt.Errorf("unable to write config file %s: %w", dest, err)
+		}
+		configs[nName] = dest
+	}
+
+	clab, warnings, err := Export(name, &topo, configs)
+	if err != nil {
+		return warnings, err
+	}
+
+	data, err = yaml.Marshal(clab)
+	if err != nil {
+		return warnings, fmt.Errorf("unable to marshal containerlab topology: %w", err)
+	}
+	if err := os.WriteFile(clabPath, data, 0644); err != nil {
+		return warnings, fmt.Errorf("unable to write containerlab file: %w", err)
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,113 @@
+package clab
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const clabTopology = `
+name: lab
+topology:
+  kinds:
+    linux:
+      image: quay.io/frrouting/frr:9.1.0
+  nodes:
+    r-1:
+      kind: linux
+      startup-config: r1.conf
+    h1:
+      kind: linux
+      image: mroy31/gonetem-host
+      exec:
+        - ip addr add 10.0.0.2/24 dev eth1
+    srl:
+      kind: nokia_srlinux
+      image: ghcr.io/nokia/srlinux
+    br1:
+      kind: bridge
+  links:
+    - endpoints: ["r-1:eth1", "br1:eth1"]
+    - endpoints: ["h1:eth1", "br1:eth2"]
+    - endpoints: ["srl:e1-1", "r-1:eth2"]
+`
+
+func writeClabTopology(t *testing.T) string {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "r1.conf"), []byte("hostname r1\n"), 0644); err != nil {
+		t.Fatalf("Unable to write startup config: %v", err)
+	}
+
+	clabPath := filepath.Join(dir, "lab.clab.yml")
+	if err := os.WriteFile(clabPath, []byte(clabTopology), 0644); err != nil {
+		t.Fatalf("Unable to write containerlab file: %v", err)
+	}
+	return clabPath
+}
+
+func TestClab_Import(t *testing.T) {
+	result, err := Import(writeClabTopology(t))
+	if err != nil {
+		t.Fatalf("Unable to import containerlab topology: %v", err)
+	}
+
+	expectedTypes := map[string]string{
+		"r_1": "docker.router",
+		"h1":  "docker.host",
+		"srl": "docker.nokia_srlinux",
+		"br1": "ovs",
+	}
+	for name, nType := range expectedTypes {
+		nConfig, found := result.Topology.Nodes[name]
+		if !found {
+			t.Errorf("Node %s not found in imported topology", name)
+		} else if nConfig.Type != nType {
+			t.Errorf("Node %s: wrong type %s != %s", name, nConfig.Type, nType)
+		}
+	}
+
+	expectedPeers := [][2]string{{"r_1.1", "br1.1"}, {"h1.1", "br1.2"}, {"srl.1", "r_1.2"}}
+	if len(result.Topology.Links) != len(expectedPeers) {
+		t.Fatalf("Wrong number of links %d != %d", len(result.Topology.Links), len(expectedPeers))
+	}
+	for idx, peers := range expectedPeers {
+		l := result.Topology.Links[idx]
+		if l.Peer1 != peers[0] || l.Peer2 != peers[1] {
+			t.Errorf("Link %d: wrong peers %s-%s", idx, l.Peer1, l.Peer2)
+		}
+	}
+
+	if string(result.Configs["r_1.frr.conf"]) != "hostname r1\n" {
+		t.Errorf("Startup config of r-1 has not been imported")
+	}
+	if _, found := result.Configs["h1.init.conf"]; !found {
+		t.Errorf("Exec commands of h1 have not been imported")
+	}
+	if len(result.ExtraNodes) != 1 || result.ExtraNodes[0].Type != "nokia_srlinux" {
+		t.Errorf("Unexpected extra nodes: %v", result.ExtraNodes)
+	}
+}
+
+func TestClab_ImportExportProject(t *testing.T) {
+	dir := t.TempDir()
+	prjPath := filepath.Join(dir, "lab.gnet")
+	if _, err := ImportProject(writeClabTopology(t), prjPath); err != nil {
+		t.Fatalf("Unable to import project: %v", err)
+	}
+
+	clabPath := filepath.Join(dir, "export.clab.yml")
+	if _, err := ExportProject(prjPath, clabPath); err != nil {
+		t.Fatalf("Unable to export project: %v", err)
+	}
+
+	result, err := Import(clabPath)
+	if err != nil {
+		t.Fatalf("Unable to import exported topology: %v", err)
+	}
+	if len(result.Topology.Nodes) != 4 || len(result.Topology.Links) != 3 {
+		t.Errorf("Exported topology is not complete: %v", result.Topology)
+	}
+	if string(result.Configs["r_1.frr.conf"]) != "hostname r1\n" {
+		t.Errorf("Startup config of r_1 has not been exported")
+	}
+}
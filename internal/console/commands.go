@@ -14,6 +14,7 @@ import (
 	"github.com/elk-language/go-prompt"
 	"github.com/elk-language/go-prompt/completer"
 	"github.com/fatih/color"
+	"github.com/mroy31/gonetem/internal/clab"
 	"github.com/mroy31/gonetem/internal/options"
 	"github.com/mroy31/gonetem/internal/proto"
 	"github.com/mroy31/gonetem/internal/utils"
@@ -385,6 +386,81 @@ var pullCmd = &cobra.Command{
 	},
 }
 
+func getImportCmd() *cobra.Command {
+	var importCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import a project from another tool",
+		Long:  "Import a project from another tool, gonetem-console import help for details",
+	}
+
+	importCmd.AddCommand(&cobra.Command{
+		Use:   "clab",
+		Short: "Create a project from a containerlab topology",
+		Long:  "Create a project from a containerlab topology: gonetem-console import clab <file.clab.yml> <project.gnet>",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if filepath.Ext(args[1]) != ".gnet" {
+				Fatal("gonetem accepts only project with .gnet extension")
+			}
+			if _, err := os.Stat(args[1]); err == nil {
+				Fatal("Project %s already exist", args[1])
+			}
+
+			result, err := clab.ImportProject(args[0], args[1])
+			if err != nil {
+				Fatal("Unable to import containerlab topology %s: \n\t%v\n", args[0], err)
+			}
+
+			for _, warning := range result.Warnings {
+				fmt.Println(color.YellowString("Warning: " + warning))
+			}
+			if len(result.ExtraNodes) > 0 {
+				fmt.Println(color.YellowString("The following extraNodes have to be declared in the server configuration:"))
+				for _, extra := range result.ExtraNodes {
+					fmt.Printf("  - type: %s\n    image: %s\n", extra.Type, extra.Image)
+					fmt.Printf("    commands:\n      console: %s\n      shell: %s\n", extra.Commands.Console, extra.Commands.Shell)
+				}
+			}
+
+			fmt.Println(color.GreenString("Project " + args[1] + " has been created"))
+		},
+	})
+
+	return importCmd
+}
+
+func getExportCmd() *cobra.Command {
+	var exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export a project to another tool",
+		Long:  "Export a project to another tool, gonetem-console export help for details",
+	}
+
+	exportCmd.AddCommand(&cobra.Command{
+		Use:   "clab",
+		Short: "Export a project to a containerlab topology",
+		Long:  "Export a project to a containerlab topology: gonetem-console export clab <project.gnet> <file.clab.yml>",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if filepath.Ext(args[0]) != ".gnet" {
+				Fatal("gonetem accepts only project with .gnet extension")
+			}
+
+			warnings, err := clab.ExportProject(args[0], args[1])
+			if err != nil {
+				Fatal("Unable to export project %s: \n\t%v\n", args[0], err)
+			}
+
+			for _, warning := range warnings {
+				fmt.Println(color.YellowString("Warning: " + warning))
+			}
+			fmt.Println(color.GreenString("Containerlab topology " + args[1] + " has been created"))
+		},
+	})
+
+	return exportCmd
+}
+
 func Init() {
 	rootCmd.PersistentFlags().StringVarP(
 		&serverFlag, "server", "s", "",
@@ -409,6 +485,8 @@ func Init() {
 	rootCmd.AddCommand(pullCmd)
 	rootCmd.AddCommand(extractCmd)
 	rootCmd.AddCommand(getConfigCmd())
+	rootCmd.AddCommand(getImportCmd())
+	rootCmd.AddCommand(getExportCmd())
 }
 
 func Execute() {
@@ -2,6 +2,7 @@ package console
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	prompt "github.com/elk-language/go-prompt"
@@ -38,7 +39,7 @@ func (c *PromptCompleter) Complete(d prompt.Document) ([]prompt.Suggest, istring
 
 	if len(args) == 2 {
 		switch args[0] {
-		case "console", "start", "stop", "restart", "shell", "viewConfig":
+		case "console", "start", "stop", "restart", "shell", "viewConfig", "logs", "pause", "resume", "pruneSnapshots":
 			suggestions := make([]prompt.Suggest, 0)
 			for _, n := range c.prt.nodes {
 				if !strings.HasPrefix(n.Name, args[1]) {
@@ -47,7 +48,7 @@ func (c *PromptCompleter) Complete(d prompt.Document) ([]prompt.Suggest, istring
 				suggestions = append(suggestions, prompt.Suggest{Text: n.Name})
 			}
 
-			if args[0] != "restart" {
+			if !slices.Contains([]string{"restart", "logs", "pause", "resume"}, args[0]) {
 				suggestions = append(suggestions, prompt.Suggest{Text: "all"})
 			}
 
@@ -11,12 +11,15 @@ import (
 	"os/signal"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/docker/docker/pkg/system"
+	"github.com/docker/go-units"
 	"github.com/elk-language/go-prompt"
 	"github.com/fatih/color"
 	"github.com/google/shlex"
@@ -38,6 +41,8 @@ const (
 var (
 	RedPrintf     = color.New(color.FgRed).PrintfFunc()
 	MagentaPrintf = color.New(color.FgMagenta).PrintfFunc()
+
+	topRefreshInterval = 2 * time.Second
 )
 
 func Fatal(msg string, a ...interface{}) {
@@ -67,10 +72,11 @@ type NetemNode struct {
 }
 
 type NetemCommand struct {
-	Desc  string
-	Usage string
-	Args  []string
-	Run   func(p *NetemPrompt, cmdArgs []string)
+	Desc    string
+	Usage   string
+	Args    []string
+	OptArgs []string
+	Run     func(p *NetemPrompt, cmdArgs []string)
 }
 
 type NetemPrompt struct {
@@ -83,6 +89,14 @@ type NetemPrompt struct {
 }
 
 func (p *NetemPrompt) RegisterCommands() {
+	p.commands["addressing"] = &NetemCommand{
+		Desc:  "Display the IP addressing plan generated from the topology",
+		Usage: "addressing",
+		Args:  []string{},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Addressing)
+		},
+	}
 	p.commands["capture"] = &NetemCommand{
 		Desc:  "Capture trafic on an interface",
 		Usage: "capture <node_name>.<if_number>",
@@ -157,6 +171,14 @@ func (p *NetemPrompt) RegisterCommands() {
 			p.execWithClient(cmdArgs, p.Edit)
 		},
 	}
+	p.commands["events"] = &NetemCommand{
+		Desc:  "Display the events of the nodes (crash, restart) until Ctrl-C is pressed",
+		Usage: "events",
+		Args:  []string{},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Events)
+		},
+	}
 	p.commands["exec"] = &NetemCommand{
 		Desc:  "Exec a command on a node",
 		Usage: "exec <node_name> <cmd>",
@@ -165,6 +187,23 @@ func (p *NetemPrompt) RegisterCommands() {
 			p.execWithClient(cmdArgs, p.Exec)
 		},
 	}
+	p.commands["forward"] = &NetemCommand{
+		Desc:    "Forward a port of the server to a node port, -d to stop forwarding it, -s to save it in the topology",
+		Usage:   "forward <node_name> <host_port>:<node_port>[/tcp|udp] [-d] [-s]",
+		Args:    []string{`^\w+$`, `^\d+:\d+(/(tcp|udp))?$`},
+		OptArgs: []string{`^-[ds]$`, `^-[ds]$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Forward)
+		},
+	}
+	p.commands["graph"] = &NetemCommand{
+		Desc:  "Export a diagram of the topology (svg is saved in the current folder)",
+		Usage: "graph dot|svg|json",
+		Args:  []string{`^(dot|svg|json)$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Graph)
+		},
+	}
 	p.commands["ifState"] = &NetemCommand{
 		Desc:  "Enable/disable a node interface",
 		Usage: "ifState <node_name>.<if_number> up|down",
@@ -173,6 +212,31 @@ func (p *NetemPrompt) RegisterCommands() {
 			p.execWithClient(cmdArgs, p.IfState)
 		},
 	}
+	p.commands["logs"] = &NetemCommand{
+		Desc:    "Display the logs of a node, -f to follow them (Ctrl-C to quit)",
+		Usage:   "logs <node_name> [-f]",
+		Args:    []string{`^\w+$`},
+		OptArgs: []string{`^-f$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Logs)
+		},
+	}
+	p.commands["pause"] = &NetemCommand{
+		Desc:  "Freeze a node without losing its links and state",
+		Usage: "pause <node_name>",
+		Args:  []string{`^\w+$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Pause)
+		},
+	}
+	p.commands["pruneSnapshots"] = &NetemCommand{
+		Desc:  "Delete the filesystem snapshot of a node or of all the nodes",
+		Usage: "pruneSnapshots <node_name>|all",
+		Args:  []string{`^\w+$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.PruneSnapshots)
+		},
+	}
 	p.commands["reload"] = &NetemCommand{
 		Desc:  "Reload the project",
 		Usage: "reload",
@@ -191,6 +255,14 @@ func (p *NetemPrompt) RegisterCommands() {
 			p.execWithClient(cmdArgs, p.Restart)
 		},
 	}
+	p.commands["resume"] = &NetemCommand{
+		Desc:  "Resume a paused node",
+		Usage: "resume <node_name>",
+		Args:  []string{`^\w+$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Resume)
+		},
+	}
 	p.commands["run"] = &NetemCommand{
 		Desc:  "Start the project",
 		Usage: "run",
@@ -231,6 +303,14 @@ func (p *NetemPrompt) RegisterCommands() {
 			})
 		},
 	}
+	p.commands["snapshots"] = &NetemCommand{
+		Desc:  "Display the filesystem snapshots stored in the project",
+		Usage: "snapshots",
+		Args:  []string{},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Snapshots)
+		},
+	}
 	p.commands["start"] = &NetemCommand{
 		Desc:  "Start a node",
 		Usage: "start <node_name>",
@@ -263,6 +343,22 @@ func (p *NetemPrompt) RegisterCommands() {
 			p.execWithClient(cmdArgs, p.Status)
 		},
 	}
+	p.commands["top"] = &NetemCommand{
+		Desc:  "Display the resource usage of the nodes, refreshed periodically (Ctrl-C to quit)",
+		Usage: "top",
+		Args:  []string{},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Top)
+		},
+	}
+	p.commands["tunnel"] = &NetemCommand{
+		Desc:  "Forward a local port to a node port through the server connection (Ctrl-C to quit)",
+		Usage: "tunnel <node_name> <remote_port> <local_port>",
+		Args:  []string{`^\w+$`, `^\d+$`, `^\d+$`},
+		Run: func(p *NetemPrompt, cmdArgs []string) {
+			p.execWithClient(cmdArgs, p.Tunnel)
+		},
+	}
 	p.commands["viewConfig"] = &NetemCommand{
 		Desc:  "Display the current configuration of a node",
 		Usage: "viewConfig <node_name>",
@@ -309,12 +405,13 @@ func (p *NetemPrompt) Execute(s string) {
 		return
 	}
 
-	// check args
-	if len(args) != len(cmd.Args)+1 {
+	// check args, optional ones are at the end of the command line
+	if len(args) < len(cmd.Args)+1 || len(args) > len(cmd.Args)+len(cmd.OptArgs)+1 {
 		RedPrintf("Wrong number of arguments for '%s'\n\tusage: %s\n", args[0], cmd.Usage)
 		return
 	}
-	for idx, argRe := range cmd.Args {
+	argsRe := append(append([]string{}, cmd.Args...), cmd.OptArgs...)
+	for idx, argRe := range argsRe[:len(args)-1] {
 		r, _ := regexp.Compile(argRe)
 		if !r.MatchString(args[idx+1]) {
 			RedPrintf("Wrong format for argument %d\n\tusage: %s\n", idx+1, cmd.Usage)
@@ -566,6 +663,66 @@ func (p *NetemPrompt) Capture(cmdArgs []string) {
 	}()
 }
 
+func (p *NetemPrompt) Logs(client proto.NetemClient, cmdArgs []string) {
+	ctx := p.getCancelContext()
+	stream, err := client.NodeLogs(ctx, &proto.NodeLogsRequest{
+		PrjId:  p.prjID,
+		Node:   cmdArgs[0],
+		Follow: len(cmdArgs) > 1,
+	})
+	if err != nil {
+		RedPrintf("Unable to get logs: %v\n", err)
+		return
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		} else if err != nil {
+			RedPrintf("Error when receiving logs: %v\n", err)
+			return
+		}
+
+		switch msg.GetCode() {
+		case proto.LogsSrvMsg_STDOUT:
+			os.Stdout.Write(msg.GetData())
+		case proto.LogsSrvMsg_STDERR:
+			os.Stderr.Write(msg.GetData())
+		case proto.LogsSrvMsg_ERROR:
+			RedPrintf("%s\n", string(msg.GetData()))
+			return
+		}
+	}
+}
+
+func (p *NetemPrompt) Events(client proto.NetemClient, cmdArgs []string) {
+	ctx := p.getCancelContext()
+	stream, err := client.ProjectEvents(ctx, &proto.ProjectRequest{Id: p.prjID})
+	if err != nil {
+		RedPrintf("Unable to get events: %v\n", err)
+		return
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		} else if err != nil {
+			RedPrintf("Error when receiving events: %v\n", err)
+			return
+		}
+
+		msgTime := time.Now().Format("15:04:05")
+		switch msg.GetCode() {
+		case proto.NodeEventMsg_RESTARTED:
+			fmt.Println(color.GreenString("%s %s", msgTime, msg.GetMessage()))
+		default:
+			fmt.Println(color.RedString("%s %s", msgTime, msg.GetMessage()))
+		}
+	}
+}
+
 func (p *NetemPrompt) IfState(client proto.NetemClient, cmdArgs []string) {
 	state, found := map[string]proto.IfState{
 		"up":   proto.IfState_UP,
@@ -592,6 +749,31 @@ func (p *NetemPrompt) IfState(client proto.NetemClient, cmdArgs []string) {
 	}
 }
 
+func (p *NetemPrompt) Forward(client proto.NetemClient, cmdArgs []string) {
+	_, err := client.NodePortForward(
+		context.Background(),
+		&proto.PortForwardRequest{
+			PrjId:  p.prjID,
+			Node:   cmdArgs[0],
+			Port:   cmdArgs[1],
+			Delete: slices.Contains(cmdArgs[2:], "-d"),
+			Sync:   slices.Contains(cmdArgs[2:], "-s"),
+		})
+	if err != nil {
+		RedPrintf("Unable to forward port: %v\n", err)
+	}
+}
+
+func (p *NetemPrompt) Tunnel(client proto.NetemClient, cmdArgs []string) {
+	remotePort, _ := strconv.Atoi(cmdArgs[1])
+	localPort, _ := strconv.Atoi(cmdArgs[2])
+
+	fmt.Printf("Forward 127.0.0.1:%d to %s:%d, press Ctrl-C to quit\n", localPort, cmdArgs[0], remotePort)
+	if err := nodeTunnel(p.getCancelContext(), client, p.prjID, cmdArgs[0], remotePort, localPort); err != nil {
+		RedPrintf("Unable to open tunnel: %v\n", err)
+	}
+}
+
 func (p *NetemPrompt) Check(client proto.NetemClient, cmdArgs []string) {
 	ack, err := client.TopologyCheck(context.Background(), &proto.ProjectRequest{Id: p.prjID})
 	if err != nil {
@@ -823,6 +1005,28 @@ func (p *NetemPrompt) StopAll(client proto.NetemClient, cmdArgs []string) {
 	}
 }
 
+func (p *NetemPrompt) Pause(client proto.NetemClient, cmdArgs []string) {
+	ack, err := client.NodePause(context.Background(), &proto.NodeRequest{PrjId: p.prjID, Node: cmdArgs[0]})
+	if err != nil {
+		RedPrintf("Unable to pause node: %v\n", err)
+	} else {
+		if ack.Status.Code == proto.StatusCode_ERROR {
+			MagentaPrintf(ack.Status.Error + "\n")
+		}
+	}
+}
+
+func (p *NetemPrompt) Resume(client proto.NetemClient, cmdArgs []string) {
+	ack, err := client.NodeResume(context.Background(), &proto.NodeRequest{PrjId: p.prjID, Node: cmdArgs[0]})
+	if err != nil {
+		RedPrintf("Unable to resume node: %v\n", err)
+	} else {
+		if ack.Status.Code == proto.StatusCode_ERROR {
+			MagentaPrintf(ack.Status.Error + "\n")
+		}
+	}
+}
+
 func (p *NetemPrompt) Restart(client proto.NetemClient, cmdArgs []string) {
 	ack, err := client.NodeRestart(context.Background(), &proto.NodeRequest{PrjId: p.prjID, Node: cmdArgs[0]})
 	if err != nil {
@@ -854,9 +1058,17 @@ func (p *NetemPrompt) Status(client proto.NetemClient, cmdArgs []string) {
 	if len(response.GetNodes()) > 0 {
 		fmt.Println("- Nodes:")
 		for _, nodeInfo := range response.GetNodes() {
-			fmt.Print("   - " + nodeInfo.GetName() + ": ")
+			fmt.Print("   - " + nodeInfo.GetName())
+			if nodeInfo.GetDescription() != "" {
+				fmt.Print(" (" + nodeInfo.GetDescription() + ")")
+			}
+			fmt.Print(": ")
 			if nodeInfo.GetRunning() {
-				fmt.Print(color.GreenString("Running\n"))
+				if nodeInfo.GetPaused() {
+					fmt.Print(color.YellowString("Paused\n"))
+				} else {
+					fmt.Print(color.GreenString("Running\n"))
+				}
 				for _, i := range nodeInfo.GetInterfaces() {
 					fmt.Print("     - " + i.GetName() + ": ")
 					switch i.GetState() {
@@ -871,6 +1083,167 @@ func (p *NetemPrompt) Status(client proto.NetemClient, cmdArgs []string) {
 			}
 		}
 	}
+
+	if len(response.GetProjectLinks()) > 0 {
+		fmt.Println("- Project links:")
+		for _, l := range response.GetProjectLinks() {
+			fmt.Print("   - " + l.GetPeer1() + " <-> " + l.GetPeer2() + ": ")
+			if l.GetConnected() {
+				fmt.Print(color.GreenString("Connected\n"))
+			} else {
+				fmt.Print(color.YellowString("Not Connected\n"))
+			}
+		}
+	}
+
+	if len(response.GetPortForwards()) > 0 {
+		fmt.Println("- Port forwards:")
+		for _, f := range response.GetPortForwards() {
+			fmt.Println("   - " + f.GetNode() + ": " + f.GetPort())
+		}
+	}
+}
+
+func (p *NetemPrompt) Top(client proto.NetemClient, cmdArgs []string) {
+	ctx := p.getCancelContext()
+	ticker := time.NewTicker(topRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		response, err := client.ProjectGetStats(ctx, &proto.ProjectRequest{Id: p.prjID})
+		if err != nil {
+			if ctx.Err() == nil {
+				RedPrintf("Unable to get project status: %v\n", err)
+			}
+			return
+		}
+
+		// clear the screen before displaying stats
+		fmt.Print("\033[H\033[2J")
+		fmt.Printf("Project %s - %s\n\n", response.GetName(), time.Now().Format("15:04:05"))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NODE\tCPU %\tMEM USAGE / LIMIT\tMEM %\tPIDS\tNET I/O")
+		for _, nodeInfo := range response.GetNodes() {
+			stats := nodeInfo.GetStats()
+			if stats == nil {
+				continue
+			}
+
+			memPercent := 0.0
+			if stats.GetMemoryLimit() > 0 {
+				memPercent = float64(stats.GetMemoryUsage()) / float64(stats.GetMemoryLimit()) * 100.0
+			}
+			fmt.Fprintf(
+				w, "%s\t%.2f%%\t%s / %s\t%.2f%%\t%d\t%s / %s\n",
+				nodeInfo.GetName(), stats.GetCpuPercent(),
+				units.BytesSize(float64(stats.GetMemoryUsage())),
+				units.BytesSize(float64(stats.GetMemoryLimit())),
+				memPercent, stats.GetPids(),
+				units.HumanSize(float64(stats.GetRxBytes())),
+				units.HumanSize(float64(stats.GetTxBytes())))
+		}
+		w.Flush()
+		fmt.Println("\nPress Ctrl-C to quit")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *NetemPrompt) Addressing(client proto.NetemClient, cmdArgs []string) {
+	response, err := client.ProjectGetAddressing(context.Background(), &proto.ProjectRequest{Id: p.prjID})
+	if err != nil {
+		RedPrintf("Unable to get addressing plan: %v\n", err)
+		return
+	}
+
+	if !response.GetEnabled() {
+		fmt.Println("No addressing section in the topology")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tINTERFACE\tIPV4\tIPV6\tNETWORK")
+	for _, a := range response.GetAssignments() {
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\n",
+			a.GetNode(), a.GetInterface(), a.GetIpv4(), a.GetIpv6(), a.GetNetwork())
+	}
+	w.Flush()
+}
+
+func (p *NetemPrompt) Snapshots(client proto.NetemClient, cmdArgs []string) {
+	response, err := client.ProjectGetSnapshots(context.Background(), &proto.ProjectRequest{Id: p.prjID})
+	if err != nil {
+		RedPrintf("Unable to get snapshots: %v\n", err)
+		return
+	} else if response.GetStatus().GetCode() == proto.StatusCode_ERROR {
+		RedPrintf(response.GetStatus().GetError() + "\n")
+		return
+	}
+
+	if len(response.GetSnapshots()) == 0 {
+		fmt.Println("No snapshot stored in the project")
+		return
+	}
+
+	var total int64
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tSIZE\tDATE")
+	for _, snapshot := range response.GetSnapshots() {
+		total += snapshot.GetSize()
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\n",
+			snapshot.GetNode(), units.HumanSize(float64(snapshot.GetSize())), snapshot.GetDate())
+	}
+	w.Flush()
+	fmt.Printf("Total: %s\n", units.HumanSize(float64(total)))
+}
+
+func (p *NetemPrompt) PruneSnapshots(client proto.NetemClient, cmdArgs []string) {
+	ack, err := client.ProjectPruneSnapshots(context.Background(), &proto.NodeRequest{PrjId: p.prjID, Node: cmdArgs[0]})
+	if err != nil {
+		RedPrintf("Unable to prune snapshots: %v\n", err)
+	} else if ack.Status.Code == proto.StatusCode_ERROR {
+		MagentaPrintf(ack.Status.Error + "\n")
+	}
+}
+
+func (p *NetemPrompt) Graph(client proto.NetemClient, cmdArgs []string) {
+	format := map[string]proto.TopologyExportRequest_Format{
+		"dot":  proto.TopologyExportRequest_DOT,
+		"svg":  proto.TopologyExportRequest_SVG,
+		"json": proto.TopologyExportRequest_JSON,
+	}[cmdArgs[0]]
+
+	response, err := client.TopologyExport(context.Background(), &proto.TopologyExportRequest{
+		Id:     p.prjID,
+		Format: format,
+		State:  true,
+	})
+	if err != nil {
+		RedPrintf("Unable to export topology: %v\n", err)
+		return
+	}
+
+	if format != proto.TopologyExportRequest_SVG {
+		fmt.Println(string(response.GetData()))
+		return
+	}
+
+	filename := "topology-" + p.prjID + ".svg"
+	if p.prjPath != "" {
+		filename = strings.TrimSuffix(path.Base(p.prjPath), ".gnet") + ".svg"
+	}
+	if err := os.WriteFile(filename, response.GetData(), 0644); err != nil {
+		RedPrintf("Unable to write %s: %v\n", filename, err)
+		return
+	}
+	fmt.Println(color.GreenString("Topology diagram saved in " + filename))
 }
 
 func (p *NetemPrompt) Edit(client proto.NetemClient, cmdArgs []string) {
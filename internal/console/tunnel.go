@@ -0,0 +1,118 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/mroy31/gonetem/internal/proto"
+)
+
+// nodeTunnel listens on localPort and forwards each connection to port of
+// the node through a NodeDial stream, until ctx is cancelled
+func nodeTunnel(ctx context.Context, client proto.NetemClient, prjId, node string, port, localPort int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		go func() {
+			if err := tunnelConn(ctx, client, prjId, node, port, conn); err != nil {
+				RedPrintf("Tunnel to %s:%d: %v\n", node, port, err)
+			}
+		}()
+	}
+}
+
+func tunnelConn(ctx context.Context, client proto.NetemClient, prjId, node string, port int, conn net.Conn) error {
+	defer conn.Close()
+
+	// the stream is cancelled if the local connection fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.NodeDial(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&proto.DialCltMsg{
+		Code:  proto.DialCltMsg_CONNECT,
+		PrjId: prjId,
+		Node:  node,
+		Port:  int32(port),
+	}); err != nil {
+		return err
+	}
+
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	switch msg.GetCode() {
+	case proto.DialSrvMsg_CONNECTED:
+	case proto.DialSrvMsg_ERROR:
+		return errors.New(string(msg.GetData()))
+	default:
+		return fmt.Errorf("unexpected code %s for first msg", msg.GetCode())
+	}
+
+	// read local connection
+	go func() {
+		data := make([]byte, 32*1024)
+		for {
+			n, err := conn.Read(data)
+			if n > 0 {
+				if err := stream.Send(&proto.DialCltMsg{
+					Code: proto.DialCltMsg_DATA,
+					Data: data[:n],
+				}); err != nil {
+					return
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+
+		stream.Send(&proto.DialCltMsg{Code: proto.DialCltMsg_CLOSE})
+		stream.CloseSend()
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		switch msg.GetCode() {
+		case proto.DialSrvMsg_DATA:
+			if _, err := conn.Write(msg.GetData()); err != nil {
+				return err
+			}
+		case proto.DialSrvMsg_CLOSE:
+			if tcpConn, ok := conn.(*net.TCPConn); ok {
+				tcpConn.CloseWrite()
+			}
+		case proto.DialSrvMsg_ERROR:
+			return errors.New(string(msg.GetData()))
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -13,10 +14,13 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/moby/term"
+	"github.com/mroy31/gonetem/internal/options"
 	"github.com/mroy31/gonetem/internal/utils"
 	"github.com/sirupsen/logrus"
 )
@@ -26,6 +30,12 @@ type NetemContainerList struct {
 	Name      string
 }
 
+type LogsOptions struct {
+	Follow bool
+	Tail   string
+	Since  string
+}
+
 type DockerClient struct {
 	cli *client.Client
 }
@@ -115,6 +125,8 @@ func (c *DockerClient) Create(
 	hostName string,
 	volumes []string,
 	ipv6, mpls bool,
+	sysctls map[string]string,
+	resources options.DockerNodeResources,
 ) (string, error) {
 	hostConfig := container.HostConfig{
 		NetworkMode: "none",
@@ -123,6 +135,17 @@ func (c *DockerClient) Create(
 		Sysctls:     make(map[string]string),
 		Binds:       volumes,
 	}
+
+	memory, err := resources.GetMemory()
+	if err != nil {
+		return "", err
+	}
+	hostConfig.Resources.Memory = memory
+	hostConfig.Resources.NanoCPUs = int64(resources.Cpus * 1e9)
+	hostConfig.Resources.CpusetCpus = resources.Cpuset
+	if resources.Pids > 0 {
+		hostConfig.Resources.PidsLimit = &resources.Pids
+	}
 	if ipv6 {
 		hostConfig.Sysctls["net.ipv6.conf.all.disable_ipv6"] = "0"
 	}
@@ -130,6 +153,9 @@ func (c *DockerClient) Create(
 		hostConfig.Sysctls["net.mpls.platform_labels"] = "100000"
 		hostConfig.Sysctls["net.mpls.conf.lo.input"] = "1"
 	}
+	for key, value := range sysctls {
+		hostConfig.Sysctls[key] = value
+	}
 
 	resp, err := c.cli.ContainerCreate(ctx, &container.Config{
 		Image:    imgName,
@@ -178,6 +204,60 @@ func (c *DockerClient) Rm(ctx context.Context, containerId string) error {
 	return c.cli.ContainerRemove(ctx, containerId, container.RemoveOptions{})
 }
 
+func (c *DockerClient) Pause(ctx context.Context, containerId string) error {
+	return c.cli.ContainerPause(ctx, containerId)
+}
+
+func (c *DockerClient) Unpause(ctx context.Context, containerId string) error {
+	return c.cli.ContainerUnpause(ctx, containerId)
+}
+
+func (c *DockerClient) Stats(ctx context.Context, containerId string) (*container.StatsResponse, error) {
+	resp, err := c.cli.ContainerStatsOneShot(ctx, containerId)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var stats container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("unable to decode stats of container %s: %w", containerId, err)
+	}
+
+	return &stats, nil
+}
+
+func (c *DockerClient) Logs(ctx context.Context, containerId string, opts LogsOptions, stdout, stderr io.Writer) error {
+	out, err := c.cli.ContainerLogs(ctx, containerId, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Tail:       opts.Tail,
+		Since:      opts.Since,
+	})
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// containers are created without tty, so output is multiplexed
+	if _, err := stdcopy.StdCopy(stdout, stderr, out); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// DieEvents returns a channel receiving an event each time a container
+// stops, until ctx is cancelled or an error occurs
+func (c *DockerClient) DieEvents(ctx context.Context) (<-chan events.Message, <-chan error) {
+	return c.cli.Events(ctx, events.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", string(events.ContainerEventType)),
+			filters.Arg("event", string(events.ActionDie)),
+		),
+	})
+}
+
 func (c *DockerClient) Pid(ctx context.Context, containerId string) (int, error) {
 	containerInfo, err := c.cli.ContainerInspect(ctx, containerId)
 	if err != nil {
@@ -285,6 +365,11 @@ func (c *DockerClient) CopyTo(ctx context.Context, containerId, source, dest str
 		pReader, container.CopyToContainerOptions{})
 }
 
+// CopyArchiveTo extracts a tar archive in the dest folder of the container
+func (c *DockerClient) CopyArchiveTo(ctx context.Context, containerId, dest string, archive io.Reader) error {
+	return c.cli.CopyToContainer(ctx, containerId, dest, archive, container.CopyToContainerOptions{})
+}
+
 func (c *DockerClient) ExecWithWorkingDir(ctx context.Context, containerId string, cmd []string, workingDir string) (string, error) {
 	config := container.ExecOptions{
 		AttachStderr: true,
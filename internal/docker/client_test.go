@@ -54,7 +54,7 @@ func setupContainer(t *testing.T, imgId DockerImageT) (*DockerClient, string, st
 	image := getImageFromT(imgId)
 	name := utils.RandString(10)
 
-	cID, err := client.Create(ctx, image, name, name, []string{}, true, true)
+	cID, err := client.Create(ctx, image, name, name, []string{}, true, true, nil, options.DockerNodeResources{})
 	if err != nil {
 		t.Fatalf("Unable to create the container: %v", err)
 	}
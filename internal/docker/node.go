@@ -10,22 +10,45 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/docker/docker/api/types/container"
 	"github.com/google/shlex"
 	"github.com/moby/term"
 	"github.com/mroy31/gonetem/internal/link"
 	"github.com/mroy31/gonetem/internal/options"
+	"github.com/mroy31/gonetem/internal/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netns"
 )
 
+const (
+	defaultReadinessInterval = 500 * time.Millisecond
+	// FilesDir is the folder of the project with the files overlays
+	// of the nodes, in files/<node>/<absolute path>
+	FilesDir = "files"
+)
+
 type VrrpOptions struct {
 	Interface int
 	Group     int
 	Address   string
 }
 
+// VlanOptions describes a 802.1Q sub-interface created on top
+// of a node interface
+type VlanOptions struct {
+	Parent    string
+	Id        int
+	Addresses []string
+}
+
+func (v VlanOptions) GetName() string {
+	return fmt.Sprintf("%s.%d", v.Parent, v.Id)
+}
+
 type DockerNodeOptions struct {
 	Name      string
 	ShortName string
@@ -33,7 +56,10 @@ type DockerNodeOptions struct {
 	Mpls      bool
 	Vrfs      []string
 	Vrrps     []VrrpOptions
+	Vlans     []VlanOptions
 	Volumes   []string
+	Resources options.DockerNodeResources
+	Sysctls   map[string]string
 }
 
 type DockerNodeStatus struct {
@@ -58,8 +84,30 @@ type DockerNode struct {
 	Mpls           bool
 	Vrfs           []string
 	Vrrps          []VrrpOptions
+	Vlans          []VlanOptions
 	Volumes        []string
+	Resources      options.DockerNodeResources
+	Sysctls        map[string]string
 	Logger         *logrus.Entry
+
+	// SnapshotRestored is set once the filesystem snapshot of the
+	// node has been applied in the container
+	SnapshotRestored bool
+	// snapshotPruned is set when the snapshot of the node has been
+	// deleted, changes of the container are not saved anymore
+	snapshotPruned bool
+	// Paused is set when the processes of the container are frozen
+	Paused bool
+	// Crashed is set when the container stops without having been
+	// asked, its interfaces have been destroyed with its netns
+	Crashed bool
+
+	// lock protects the state of the node (Running, Paused, Crashed and
+	// Interfaces) updated by the docker events watcher
+	lock         sync.Mutex
+	statsLock    sync.Mutex
+	lastCPUStats *container.CPUStats
+	stopping     atomic.Bool
 }
 
 func (n *DockerNode) GetName() string {
@@ -98,7 +146,162 @@ func (n *DockerNode) GetStatus() (DockerNodeStatus, error) {
 	}, nil
 }
 
+func (n *DockerNode) GetStats() (DockerNodeStats, error) {
+	if !n.IsRunning() {
+		return DockerNodeStats{}, fmt.Errorf("node %s not running", n.GetName())
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return DockerNodeStats{}, err
+	}
+	defer client.Close()
+
+	stats, err := client.Stats(context.Background(), n.ID)
+	if err != nil {
+		return DockerNodeStats{}, err
+	}
+
+	nodeStats := DockerNodeStats{
+		MemoryUsage: computeMemoryUsage(stats.MemoryStats),
+		MemoryLimit: stats.MemoryStats.Limit,
+		Pids:        stats.PidsStats.Current,
+	}
+
+	// one shot stats do not include the previous sample,
+	// so cpu usage is computed from the last call
+	n.statsLock.Lock()
+	if n.lastCPUStats != nil {
+		nodeStats.CPUPercent = computeCPUPercent(*n.lastCPUStats, stats.CPUStats)
+	}
+	n.lastCPUStats = &stats.CPUStats
+	n.statsLock.Unlock()
+
+	// containers do not use docker networks, read the counters
+	// of the interfaces from the node netns
+	ns, err := n.GetRunningNetns()
+	if err != nil {
+		n.Logger.Warnf("Unable to get network statistics: %v", err)
+		return nodeStats, nil
+	}
+	defer ns.Close()
+
+	for ifName := range n.GetInterfacesState() {
+		ifStats, err := link.GetInterfaceStatistics(ifName, ns)
+		if err != nil {
+			n.Logger.Warnf("Unable to get statistics of %s: %v", ifName, err)
+			continue
+		}
+		nodeStats.RxBytes += ifStats.RxBytes
+		nodeStats.TxBytes += ifStats.TxBytes
+	}
+
+	return nodeStats, nil
+}
+
+func (n *DockerNode) IsPaused() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.Paused
+}
+
+// IsCrashed returns true if the container has stopped without having
+// been asked since its last start
+func (n *DockerNode) IsCrashed() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.Crashed
+}
+
+// Pause freezes all the processes of the container, interfaces
+// and state are kept
+func (n *DockerNode) Pause() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if !n.Running {
+		return fmt.Errorf("node %s not running", n.GetName())
+	}
+	if n.Paused {
+		return nil
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Pause(context.Background(), n.ID); err != nil {
+		return err
+	}
+	n.Paused = true
+
+	return nil
+}
+
+func (n *DockerNode) Resume() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.resume()
+}
+
+func (n *DockerNode) resume() error {
+	if !n.Paused {
+		return nil
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Unpause(context.Background(), n.ID); err != nil {
+		return err
+	}
+	n.Paused = false
+
+	return nil
+}
+
+// checkNotPaused returns an error if the node is paused, docker refuses
+// to exec commands in a frozen container
+func (n *DockerNode) checkNotPaused() error {
+	if n.IsPaused() {
+		return fmt.Errorf("node %s is paused, resume it first", n.Name)
+	}
+	return nil
+}
+
+// HandleDie updates the state of the node when its container stops.
+// It returns true if the stop has not been requested by gonetem
+func (n *DockerNode) HandleDie() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if !n.Running || n.stopping.Load() {
+		return false
+	}
+
+	n.Logger.Warn("Container stopped unexpectedly")
+	n.Running = false
+	n.Paused = false
+	n.ConfigLoaded = false
+	n.Crashed = true
+	// interfaces have been destroyed with the netns of the container
+	n.Interfaces = make(map[string]*DockerInterface)
+
+	return true
+}
+
 func (n *DockerNode) IsRunning() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	return n.Running
 }
 
@@ -121,7 +324,11 @@ func (n *DockerNode) Create(imgName string, ipv6 bool) error {
 	containerName := fmt.Sprintf("%s%s.%s", options.NETEM_ID, n.PrjID, n.Name)
 	volumes := slices.Concat(n.Config.Volumes, n.Volumes)
 
-	if n.ID, err = client.Create(ctx, imgName, containerName, n.Name, volumes, ipv6, n.Mpls); err != nil {
+	resources := n.Config.Resources.Merge(n.Resources)
+	// sysctls of interfaces are set once links are created
+	sysctls, _ := SplitSysctls(MergeSysctls(n.Config.Sysctls, n.Sysctls))
+
+	if n.ID, err = client.Create(ctx, imgName, containerName, n.Name, volumes, ipv6, n.Mpls, sysctls, resources); err != nil {
 		return err
 	}
 
@@ -202,9 +409,21 @@ func (n *DockerNode) prepareInterface(client *DockerClient, ifName string) {
 			n.Logger.Warnf("Unable to enable MPLS on %s", ifName)
 		}
 	}
+
+	// set sysctls referencing this interface
+	_, ifSysctls := SplitSysctls(MergeSysctls(n.Config.Sysctls, n.Sysctls))
+	for key, value := range ifSysctls[ifName] {
+		cmd = []string{"sysctl", "-w", key + "=" + value}
+		if _, err := client.Exec(context.Background(), n.ID, cmd); err != nil {
+			n.Logger.Warnf("Unable to set sysctl %s on %s", key, ifName)
+		}
+	}
 }
 
 func (n *DockerNode) AttachInterface(ifName string, ifIndex int, configure bool) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	n.Interfaces[ifName] = &DockerInterface{
 		Configured: false,
 		State:      link.IFSTATE_UP,
@@ -224,7 +443,40 @@ func (n *DockerNode) AttachInterface(ifName string, ifIndex int, configure bool)
 	return nil
 }
 
+// DetachInterface forgets an interface deleted outside of the node
+func (n *DockerNode) DetachInterface(ifName string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	delete(n.Interfaces, ifName)
+	return nil
+}
+
+// SetupBond aggregates the interfaces in a bond, it has to be called
+// again each time the node is started
+func (n *DockerNode) SetupBond(name string, ifIndexes []int, opts link.BondOptions) error {
+	if !n.Running {
+		return nil
+	}
+
+	ns, err := n.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	members := make([]string, len(ifIndexes))
+	for idx, ifIndex := range ifIndexes {
+		members[idx] = n.GetInterfaceName(ifIndex)
+	}
+
+	return link.CreateBond(name, members, opts, ns)
+}
+
 func (n *DockerNode) ConfigureInterfaces() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	if !n.Running {
 		return nil
 	}
@@ -248,10 +500,12 @@ func (n *DockerNode) ConfigureInterfaces() error {
 }
 
 func (n *DockerNode) AttachMgntInterface(ifName string, ns netns.NsHandle, IPAddress string) error {
+	n.lock.Lock()
 	n.Interfaces[ifName] = &DockerInterface{
 		Configured: true,
 		State:      link.IFSTATE_UP,
 	}
+	n.lock.Unlock()
 
 	// Set IP address
 	if err := link.IpAddressAdd(ifName, ns, IPAddress); err != nil {
@@ -265,6 +519,9 @@ func (n *DockerNode) Capture(ifIndex int, out io.Writer) error {
 	if !n.Running {
 		return errors.New("not running")
 	}
+	if err := n.checkNotPaused(); err != nil {
+		return err
+	}
 
 	client, err := NewDockerClient()
 	if err != nil {
@@ -277,6 +534,16 @@ func (n *DockerNode) Capture(ifIndex int, out io.Writer) error {
 	return client.ExecOutStream(context.Background(), n.ID, cmd, out)
 }
 
+func (n *DockerNode) Logs(ctx context.Context, opts LogsOptions, stdout, stderr io.Writer) error {
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Logs(ctx, n.ID, opts, stdout, stderr)
+}
+
 func (n *DockerNode) ExecCommand(
 	cmd []string,
 	in io.ReadCloser,
@@ -288,6 +555,9 @@ func (n *DockerNode) ExecCommand(
 	if !n.Running {
 		return errors.New("not running")
 	}
+	if err := n.checkNotPaused(); err != nil {
+		return err
+	}
 
 	client, err := NewDockerClient()
 	if err != nil {
@@ -304,6 +574,10 @@ func (n *DockerNode) ExecCommand(
 }
 
 func (n *DockerNode) GetConsoleCmd(shell bool) ([]string, error) {
+	if err := n.checkNotPaused(); err != nil {
+		return []string{}, err
+	}
+
 	cmd := n.Config.Commands.Console
 	if shell {
 		cmd = n.Config.Commands.Shell
@@ -318,6 +592,9 @@ func (n *DockerNode) GetConsoleCmd(shell bool) ([]string, error) {
 }
 
 func (n *DockerNode) Start() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	if !n.Running {
 		n.Logger.Debug("Start Node")
 
@@ -332,6 +609,7 @@ func (n *DockerNode) Start() error {
 			return err
 		}
 		n.Running = true
+		n.Crashed = false
 
 		// Attach existing interfaces
 		currentNS, err := n.GetLocalNetns()
@@ -346,7 +624,7 @@ func (n *DockerNode) Start() error {
 		}
 		defer targetNS.Close()
 
-		ifStates := n.GetInterfacesState()
+		ifStates := n.interfacesState()
 		if err := link.MoveInterfacesNetns(ifStates, currentNS, targetNS); err != nil {
 			return fmt.Errorf("unable to attach interfaces: %v", err)
 		}
@@ -361,9 +639,17 @@ func (n *DockerNode) Start() error {
 }
 
 func (n *DockerNode) Stop() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	if n.Running {
 		n.Logger.Debug("Stop Node")
 
+		// a frozen container can not be stopped gracefully
+		if err := n.resume(); err != nil {
+			return err
+		}
+
 		client, err := NewDockerClient()
 		if err != nil {
 			return err
@@ -383,11 +669,14 @@ func (n *DockerNode) Stop() error {
 		}
 		defer targetNS.Close()
 
-		ifStates := n.GetInterfacesState()
+		ifStates := n.interfacesState()
 		if err := link.MoveInterfacesNetns(ifStates, currentNS, targetNS); err != nil {
 			return fmt.Errorf("unable to attach interfaces: %v", err)
 		}
 
+		n.stopping.Store(true)
+		defer n.stopping.Store(false)
+
 		ctx := context.Background()
 		if err := client.Stop(ctx, n.ID); err != nil {
 			return err
@@ -399,6 +688,75 @@ func (n *DockerNode) Stop() error {
 	return nil
 }
 
+// WaitReady executes the readiness command of the node until it succeeds.
+// timeout is used when the probe does not define its own one
+func (n *DockerNode) WaitReady(timeout int) error {
+	probe := n.Config.Readiness
+	if !n.Running || probe.Command == "" {
+		return nil
+	}
+	if err := n.checkNotPaused(); err != nil {
+		return err
+	}
+
+	cmd, err := shlex.Split(probe.Command)
+	if err != nil {
+		return fmt.Errorf("unable to parse readiness command %s: %v", probe.Command, err)
+	}
+
+	interval := time.Duration(probe.Interval) * time.Millisecond
+	if interval <= 0 {
+		interval = defaultReadinessInterval
+	}
+	if probe.Timeout > 0 {
+		timeout = probe.Timeout
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	attempt, err := pollProbe(ctx, interval, func(ctx context.Context) error {
+		_, err := client.Exec(ctx, n.ID, cmd)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("node %s not ready after %d attempts: %v", n.Name, attempt, err)
+	}
+	n.Logger.Debugf("Node ready after %d attempts", attempt)
+
+	return nil
+}
+
+// pollProbe runs probe every interval until it succeeds or ctx is done.
+// It returns the number of attempts and the last error of the probe
+func pollProbe(ctx context.Context, interval time.Duration, probe func(context.Context) error) (int, error) {
+	attempt := 0
+	for {
+		err := probe(ctx)
+		attempt++
+		if err == nil {
+			return attempt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(interval):
+		}
+	}
+}
+
 func (n *DockerNode) LoadConfig(confPath string, timeout int) ([]string, error) {
 	var messages []string
 
@@ -458,6 +816,31 @@ func (n *DockerNode) LoadConfig(confPath string, timeout int) ([]string, error)
 			}
 		}
 
+		// create vlan sub-interfaces, they are destroyed with the netns
+		// of the container so they are created again at each start. Vlans
+		// of interfaces attached later are created by the server
+		for _, vlan := range n.Vlans {
+			if !link.IsLinkExist(vlan.Parent, ns) {
+				n.Logger.Infof("Vlan %s not created: interface %s not found", vlan.GetName(), vlan.Parent)
+				continue
+			}
+			if link.IsLinkExist(vlan.GetName(), ns) {
+				continue
+			}
+
+			if _, err := link.CreateVlan(vlan.GetName(), vlan.Parent, vlan.Id, ns); err != nil {
+				return messages, err
+			}
+			if err := link.SetInterfaceState(vlan.GetName(), ns, link.IFSTATE_UP); err != nil {
+				return messages, err
+			}
+			for _, addr := range vlan.Addresses {
+				if err := link.IpAddressReplace(vlan.GetName(), ns, addr); err != nil {
+					return messages, err
+				}
+			}
+		}
+
 		// Load confifuration files/folders
 		if _, err := os.Stat(confPath); err == nil {
 			for _, confFileOpts := range n.Config.ConfigurationFiles {
@@ -488,6 +871,11 @@ func (n *DockerNode) LoadConfig(confPath string, timeout int) ([]string, error)
 			}
 		}
 
+		// Copy files overlay of the node
+		if err := n.copyFilesOverlay(ctx, client, confPath); err != nil {
+			return messages, err
+		}
+
 		// Execute load config commands
 		for _, loadConfigCmd := range n.Config.Commands.LoadConfig {
 			canExec := true
@@ -520,6 +908,30 @@ func (n *DockerNode) LoadConfig(confPath string, timeout int) ([]string, error)
 	return messages, nil
 }
 
+// GetFilesOverlayPath returns the folder of the project containing the
+// files to copy in the node, from the configs folder of the project
+func (n *DockerNode) GetFilesOverlayPath(confPath string) string {
+	return path.Join(path.Dir(confPath), FilesDir, n.Name)
+}
+
+func (n *DockerNode) copyFilesOverlay(ctx context.Context, client *DockerClient, confPath string) error {
+	overlayPath := n.GetFilesOverlayPath(confPath)
+	if stat, err := os.Stat(overlayPath); err != nil || !stat.IsDir() {
+		return nil
+	}
+
+	pReader, pWriter := io.Pipe()
+	go func() {
+		pWriter.CloseWithError(utils.CreateOverlayArchive(overlayPath, pWriter))
+	}()
+
+	if err := client.CopyArchiveTo(ctx, n.ID, "/", pReader); err != nil {
+		pReader.Close()
+		return fmt.Errorf("node %s - unable to copy files overlay:\n\t%w", n.Name, err)
+	}
+	return nil
+}
+
 func (n *DockerNode) ReadConfigFiles(confDir string, timeout int) (map[string][]byte, error) {
 	configFilesData := make(map[string][]byte)
 
@@ -568,6 +980,19 @@ func (n *DockerNode) Save(dstPath string, timeout int) error {
 		return nil
 	}
 
+	// save commands are executed in the container, a paused node is
+	// resumed during the save and paused again afterwards
+	if n.IsPaused() {
+		if err := n.Resume(); err != nil {
+			return fmt.Errorf("node %s - unable to resume paused node: %w", n.Name, err)
+		}
+		defer func() {
+			if err := n.Pause(); err != nil {
+				n.Logger.Warnf("Save: unable to pause node again: %v", err)
+			}
+		}()
+	}
+
 	client, err := NewDockerClient()
 	if err != nil {
 		return err
@@ -658,6 +1083,13 @@ func (n *DockerNode) CopyTo(source, dest string) error {
 }
 
 func (n *DockerNode) GetInterfacesState() map[string]link.IfState {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.interfacesState()
+}
+
+func (n *DockerNode) interfacesState() map[string]link.IfState {
 	ifStates := make(map[string]link.IfState)
 
 	for ifName, st := range n.Interfaces {
@@ -668,6 +1100,9 @@ func (n *DockerNode) GetInterfacesState() map[string]link.IfState {
 }
 
 func (n *DockerNode) SetInterfaceState(ifIndex int, state link.IfState) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	for ifName, st := range n.Interfaces {
 		if ifName == n.GetInterfaceName(ifIndex) {
 			if state != st.State {
@@ -694,6 +1129,9 @@ func (n *DockerNode) SetInterfaceState(ifIndex int, state link.IfState) error {
 }
 
 func (n *DockerNode) Close() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	if n.ID != "" {
 		n.Logger.Debug("Close node")
 
@@ -705,6 +1143,10 @@ func (n *DockerNode) Close() error {
 
 		ctx := context.Background()
 		if n.Running {
+			n.resume()
+			n.stopping.Store(true)
+			defer n.stopping.Store(false)
+
 			client.Stop(ctx, n.ID)
 			n.Running = false
 		}
@@ -725,7 +1167,7 @@ func (n *DockerNode) Close() error {
 	return nil
 }
 
-func getDockerConfigFromType(nType string) (*options.DockerNodeConfig, error) {
+func GetDockerConfigFromType(nType string) (*options.DockerNodeConfig, error) {
 	switch nType {
 	case "router":
 		return &options.ServerConfig.Docker.Nodes.Router, nil
@@ -746,7 +1188,7 @@ func getDockerConfigFromType(nType string) (*options.DockerNodeConfig, error) {
 }
 
 func NewDockerNode(prjID string, nType string, dockerOpts DockerNodeOptions) (*DockerNode, error) {
-	nConfig, err := getDockerConfigFromType(nType)
+	nConfig, err := GetDockerConfigFromType(nType)
 	if err != nil {
 		return nil, err
 	}
@@ -760,7 +1202,10 @@ func NewDockerNode(prjID string, nType string, dockerOpts DockerNodeOptions) (*D
 		Mpls:       dockerOpts.Mpls,
 		Vrfs:       dockerOpts.Vrfs,
 		Vrrps:      dockerOpts.Vrrps,
+		Vlans:      dockerOpts.Vlans,
 		Volumes:    dockerOpts.Volumes,
+		Resources:  dockerOpts.Resources,
+		Sysctls:    dockerOpts.Sysctls,
 		Interfaces: make(map[string]*DockerInterface),
 		Logger: logrus.WithFields(logrus.Fields{
 			"project": prjID,
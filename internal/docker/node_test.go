@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/mroy31/gonetem/internal/link"
 	"github.com/mroy31/gonetem/internal/options"
@@ -333,6 +334,7 @@ func TestDockerNode_AttachLink(t *testing.T) {
 	_, err = link.CreateVethLink(
 		node1.GetInterfaceName(0), node1Netns,
 		node2.GetInterfaceName(0), node2Netns,
+		link.VethOptions{},
 	)
 	if err != nil {
 		t.Fatalf("Unable to create veth: %v", err)
@@ -381,3 +383,68 @@ func TestDockerNode_Volumes(t *testing.T) {
 		t.Fatalf("File %s is not present in the binding volume", nTarget)
 	}
 }
+
+func TestDockerNode_PollProbe(t *testing.T) {
+	failures := func(n int) func(context.Context) error {
+		calls := 0
+		return func(ctx context.Context) error {
+			calls++
+			if calls <= n {
+				return fmt.Errorf("probe failure %d", calls)
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		desc          string
+		probe         func(context.Context) error
+		timeout       time.Duration
+		expectAttempt int
+		expectError   bool
+	}{
+		{
+			desc:          "PollProbe: ready at first attempt",
+			probe:         failures(0),
+			timeout:       time.Second,
+			expectAttempt: 1,
+		},
+		{
+			desc:          "PollProbe: ready after retries",
+			probe:         failures(3),
+			timeout:       time.Second,
+			expectAttempt: 4,
+		},
+		{
+			desc:        "PollProbe: timeout",
+			probe:       failures(1000),
+			timeout:     50 * time.Millisecond,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			attempt, err := pollProbe(ctx, 10*time.Millisecond, tt.probe)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("pollProbe succeeds after %d attempts, error expected", attempt)
+				}
+				if attempt < 2 {
+					t.Errorf("pollProbe: probe not retried before timeout (%d attempts)", attempt)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("pollProbe: %v", err)
+			}
+			if attempt != tt.expectAttempt {
+				t.Errorf("pollProbe: %d attempts, %d expected", attempt, tt.expectAttempt)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+var (
+	// paths managed by docker/kernel or only used at runtime,
+	// never stored in snapshots
+	snapshotExcludedPaths = []string{"/dev", "/proc", "/run", "/sys", "/tmp", "/var/run"}
+)
+
+func SnapshotArchiveName(nodeName string) string {
+	return nodeName + ".tar.gz"
+}
+
+func SnapshotDeletedName(nodeName string) string {
+	return nodeName + ".deleted"
+}
+
+func isSnapshotExcluded(p string) bool {
+	for _, excluded := range snapshotExcludedPaths {
+		if p == excluded || strings.HasPrefix(p, excluded+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// selectSnapshotPaths returns the paths to copy from the container to
+// build its snapshot and the deleted ones. An added directory is copied
+// with all its content, so its children are not returned. A modified
+// directory is not copied, only its changed children are.
+func selectSnapshotPaths(changes []container.FilesystemChange, isDir func(string) bool) ([]string, []string) {
+	added := make(map[string]bool)
+	for _, change := range changes {
+		if change.Kind == container.ChangeAdd {
+			added[change.Path] = true
+		}
+	}
+
+	var copied, deleted []string
+	for _, change := range changes {
+		if isSnapshotExcluded(change.Path) || added[path.Dir(change.Path)] {
+			continue
+		}
+
+		switch change.Kind {
+		case container.ChangeAdd:
+			copied = append(copied, change.Path)
+		case container.ChangeModify:
+			if !isDir(change.Path) {
+				copied = append(copied, change.Path)
+			}
+		case container.ChangeDelete:
+			deleted = append(deleted, change.Path)
+		}
+	}
+
+	sort.Strings(copied)
+	sort.Strings(deleted)
+	return copied, deleted
+}
+
+// withTimeout returns a context for one docker operation, a large diff
+// requires many operations so the timeout is not shared between them
+func withTimeout(timeout int) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// ExportChanges writes in w a gzipped tar archive containing the given
+// paths of the container, with absolute names. timeout applies to the
+// copy of each path
+func (c *DockerClient) ExportChanges(containerId string, paths []string, timeout int, w io.Writer) error {
+	gzWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	for _, p := range paths {
+		if err := c.exportPath(containerId, p, timeout, tarWriter); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzWriter.Close()
+}
+
+func (c *DockerClient) exportPath(containerId, p string, timeout int, tarWriter *tar.Writer) error {
+	ctx, cancel := withTimeout(timeout)
+	defer cancel()
+
+	reader, _, err := c.cli.CopyFromContainer(ctx, containerId, p)
+	if err != nil {
+		return fmt.Errorf("unable to copy %s: %w", p, err)
+	}
+	defer reader.Close()
+
+	// entries are named from the base name of the path
+	srcTar := tar.NewReader(reader)
+	for {
+		header, err := srcTar.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to read archive of %s: %w", p, err)
+		}
+
+		header.Name = strings.TrimPrefix(path.Join(path.Dir(p), header.Name), "/")
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tarWriter, srcTar); err != nil {
+			return err
+		}
+	}
+}
+
+// SaveSnapshot stores the changes of the container filesystem in dstPath.
+// Nothing is done until a snapshot has been restored in the container,
+// to not overwrite the stored one with an incomplete diff
+func (n *DockerNode) SaveSnapshot(dstPath string, timeout int) error {
+	n.lock.Lock()
+	restored := n.SnapshotRestored
+	n.lock.Unlock()
+	if n.ID == "" || !restored {
+		return nil
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := withTimeout(timeout)
+	changes, err := client.cli.ContainerDiff(ctx, n.ID)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to get filesystem changes: %w", err)
+	}
+
+	copied, deleted := selectSnapshotPaths(changes, func(p string) bool {
+		ctx, cancel := withTimeout(timeout)
+		defer cancel()
+
+		stat, err := client.cli.ContainerStatPath(ctx, n.ID, p)
+		return err == nil && stat.Mode.IsDir()
+	})
+
+	if err := os.MkdirAll(dstPath, 0755); err != nil {
+		return fmt.Errorf("unable to create snapshots dir %s: %w", dstPath, err)
+	}
+
+	// the previous snapshot is kept if the export fails
+	err = writeSnapshotFile(dstPath, SnapshotArchiveName(n.Name), func(w io.Writer) error {
+		if err := client.ExportChanges(n.ID, copied, timeout, w); err != nil {
+			return fmt.Errorf("unable to export filesystem changes: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeSnapshotFile(dstPath, SnapshotDeletedName(n.Name), func(w io.Writer) error {
+		_, err := io.WriteString(w, strings.Join(deleted, "\n"))
+		return err
+	})
+}
+
+// writeSnapshotFile writes a file of a snapshot in a temporary file, which
+// replaces dstPath/name only once it is complete
+func writeSnapshotFile(dstPath, name string, write func(io.Writer) error) error {
+	tmp, err := os.CreateTemp(dstPath, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path.Join(dstPath, name))
+}
+
+// PruneSnapshot stops saving the changes of the container in snapshots,
+// once the stored snapshot has been deleted. Current changes stay in the
+// container until it is created again, when the project is reopened
+func (n *DockerNode) PruneSnapshot() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.SnapshotRestored = false
+	n.snapshotPruned = true
+}
+
+// RestoreSnapshot applies the snapshot stored in srcPath, if any, in
+// the container. It must be called before loading the configuration
+func (n *DockerNode) RestoreSnapshot(srcPath string, timeout int) error {
+	n.lock.Lock()
+	skip := !n.Running || n.SnapshotRestored || n.snapshotPruned
+	n.lock.Unlock()
+	if skip {
+		return nil
+	}
+
+	client, err := NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	if deletedFile, err := os.Open(path.Join(srcPath, SnapshotDeletedName(n.Name))); err == nil {
+		defer deletedFile.Close()
+
+		cmd := []string{"rm", "-rf"}
+		scanner := bufio.NewScanner(deletedFile)
+		for scanner.Scan() {
+			if p := strings.TrimSpace(scanner.Text()); p != "" {
+				cmd = append(cmd, p)
+			}
+		}
+
+		if len(cmd) > 2 {
+			if _, err := client.Exec(ctx, n.ID, cmd); err != nil {
+				return fmt.Errorf("unable to remove deleted files: %w", err)
+			}
+		}
+	}
+
+	if archive, err := os.Open(path.Join(srcPath, SnapshotArchiveName(n.Name))); err == nil {
+		defer archive.Close()
+
+		if err := client.cli.CopyToContainer(ctx, n.ID, "/", archive, container.CopyToContainerOptions{}); err != nil {
+			return fmt.Errorf("unable to restore snapshot: %w", err)
+		}
+	}
+
+	n.lock.Lock()
+	n.SnapshotRestored = true
+	n.lock.Unlock()
+	return nil
+}
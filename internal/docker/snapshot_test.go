@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestSnapshot_SelectPaths(t *testing.T) {
+	changes := []container.FilesystemChange{
+		{Kind: container.ChangeModify, Path: "/etc"},
+		{Kind: container.ChangeModify, Path: "/etc/frr/frr.conf"},
+		{Kind: container.ChangeAdd, Path: "/opt/tool"},
+		{Kind: container.ChangeAdd, Path: "/opt/tool/bin"},
+		{Kind: container.ChangeDelete, Path: "/usr/share/doc"},
+		{Kind: container.ChangeAdd, Path: "/tmp/custom.net.conf"},
+		{Kind: container.ChangeModify, Path: "/run"},
+	}
+	dirs := map[string]bool{"/etc": true, "/opt/tool": true, "/opt/tool/bin": true}
+
+	copied, deleted := selectSnapshotPaths(changes, func(p string) bool { return dirs[p] })
+
+	expectedCopied := []string{"/etc/frr/frr.conf", "/opt/tool"}
+	if !reflect.DeepEqual(copied, expectedCopied) {
+		t.Errorf("Wrong copied paths %v != %v", copied, expectedCopied)
+	}
+	if !reflect.DeepEqual(deleted, []string{"/usr/share/doc"}) {
+		t.Errorf("Wrong deleted paths %v", deleted)
+	}
+}
+
+func TestSnapshot_WriteFile(t *testing.T) {
+	dir := t.TempDir()
+	dstFile := path.Join(dir, "R1.deleted")
+	if err := os.WriteFile(dstFile, []byte("/etc/old"), 0644); err != nil {
+		t.Fatalf("Unable to write file: %v", err)
+	}
+
+	// a failed write keeps the previous file
+	err := writeSnapshotFile(dir, "R1.deleted", func(w io.Writer) error {
+		io.WriteString(w, "/etc/partial")
+		return fmt.Errorf("export failed")
+	})
+	if err == nil {
+		t.Fatalf("An error is expected")
+	}
+	if data, _ := os.ReadFile(dstFile); string(data) != "/etc/old" {
+		t.Fatalf("Previous file has been overwritten: %s", data)
+	}
+
+	err = writeSnapshotFile(dir, "R1.deleted", func(w io.Writer) error {
+		_, err := io.WriteString(w, "/etc/new")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Unable to write file: %v", err)
+	}
+	if data, _ := os.ReadFile(dstFile); string(data) != "/etc/new" {
+		t.Fatalf("File has not been replaced: %s", data)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Temporary files remain: %v", entries)
+	}
+}
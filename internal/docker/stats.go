@@ -0,0 +1,45 @@
+package docker
+
+import (
+	"github.com/docker/docker/api/types/container"
+)
+
+type DockerNodeStats struct {
+	CPUPercent  float64
+	MemoryUsage uint64
+	MemoryLimit uint64
+	Pids        uint64
+	RxBytes     uint64
+	TxBytes     uint64
+}
+
+// computeCPUPercent returns the CPU usage between two samples, using
+// the same formula as the docker stats command (100% = one full CPU)
+func computeCPUPercent(previous, current container.CPUStats) float64 {
+	cpuDelta := float64(current.CPUUsage.TotalUsage) - float64(previous.CPUUsage.TotalUsage)
+	systemDelta := float64(current.SystemUsage) - float64(previous.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0.0
+	}
+
+	onlineCPUs := float64(current.OnlineCPUs)
+	if onlineCPUs == 0.0 {
+		onlineCPUs = float64(len(current.CPUUsage.PercpuUsage))
+	}
+
+	return (cpuDelta / systemDelta) * onlineCPUs * 100.0
+}
+
+// computeMemoryUsage returns the memory used by the container without
+// the page cache, like the docker stats command
+func computeMemoryUsage(stats container.MemoryStats) uint64 {
+	// cgroup v1
+	if v, found := stats.Stats["total_inactive_file"]; found && v < stats.Usage {
+		return stats.Usage - v
+	}
+	// cgroup v2
+	if v, found := stats.Stats["inactive_file"]; found && v < stats.Usage {
+		return stats.Usage - v
+	}
+	return stats.Usage
+}
@@ -0,0 +1,36 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestStats_CPUPercent(t *testing.T) {
+	previous := container.CPUStats{
+		CPUUsage:    container.CPUUsage{TotalUsage: 1000},
+		SystemUsage: 10000,
+	}
+	current := container.CPUStats{
+		CPUUsage:    container.CPUUsage{TotalUsage: 2000},
+		SystemUsage: 14000,
+		OnlineCPUs:  2,
+	}
+
+	if percent := computeCPUPercent(previous, current); percent != 50.0 {
+		t.Errorf("Wrong cpu percent %f != 50.0", percent)
+	}
+	if percent := computeCPUPercent(current, current); percent != 0.0 {
+		t.Errorf("Wrong cpu percent without delta %f != 0.0", percent)
+	}
+}
+
+func TestStats_MemoryUsage(t *testing.T) {
+	stats := container.MemoryStats{
+		Usage: 1000,
+		Stats: map[string]uint64{"inactive_file": 200},
+	}
+	if usage := computeMemoryUsage(stats); usage != 800 {
+		t.Errorf("Wrong memory usage %d != 800", usage)
+	}
+}
@@ -0,0 +1,67 @@
+package docker
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var (
+	// namespaced sysctls accepted by docker in HostConfig.Sysctls
+	sysctlAllowedRE = regexp.MustCompile(`^(kernel\.(msgmax|msgmnb|msgmni|sem|shmall|shmmax|shmmni|shm_rmid_forced)|fs\.mqueue\.[\w.]+|net\.[\w.-]+)$`)
+	// sysctls of node interfaces, that only exist once links are created
+	sysctlInterfaceRE = regexp.MustCompile(`^net\.(ipv4|ipv6|mpls)\.(conf|neigh)\.(eth\d+)\.[\w.]+$`)
+)
+
+// CheckSysctl returns an error if the key is not a namespaced sysctl
+// that can be set in a container
+func CheckSysctl(key string) error {
+	if !sysctlAllowedRE.MatchString(key) {
+		return fmt.Errorf("sysctl '%s' is not namespaced or not allowed", key)
+	}
+	return nil
+}
+
+// GetSysctlInterface returns the interface referenced by a sysctl key,
+// an empty string for global keys
+func GetSysctlInterface(key string) string {
+	match := sysctlInterfaceRE.FindStringSubmatch(key)
+	if match == nil {
+		return ""
+	}
+	return match[3]
+}
+
+// MergeSysctls returns the default sysctls completed/overridden
+// by the ones of the node
+func MergeSysctls(defaults, override map[string]string) map[string]string {
+	sysctls := make(map[string]string)
+	for key, value := range defaults {
+		sysctls[key] = value
+	}
+	for key, value := range override {
+		sysctls[key] = value
+	}
+	return sysctls
+}
+
+// SplitSysctls separates sysctls that can be set at container creation
+// from the ones referencing interfaces, indexed by interface name
+func SplitSysctls(sysctls map[string]string) (map[string]string, map[string]map[string]string) {
+	global := make(map[string]string)
+	perInterface := make(map[string]map[string]string)
+
+	for key, value := range sysctls {
+		ifName := GetSysctlInterface(key)
+		if ifName == "" {
+			global[key] = value
+			continue
+		}
+
+		if _, ok := perInterface[ifName]; !ok {
+			perInterface[ifName] = make(map[string]string)
+		}
+		perInterface[ifName][key] = value
+	}
+
+	return global, perInterface
+}
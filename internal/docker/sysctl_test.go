@@ -0,0 +1,51 @@
+package docker
+
+import "testing"
+
+func TestSysctl_Check(t *testing.T) {
+	tests := []struct {
+		key   string
+		valid bool
+	}{
+		{"net.ipv4.ip_forward", true},
+		{"net.ipv4.conf.eth0.rp_filter", true},
+		{"net.ipv4.fib_multipath_hash_policy", true},
+		{"kernel.shmmax", true},
+		{"fs.mqueue.msg_max", true},
+		{"kernel.hostname", false},
+		{"vm.swappiness", false},
+		{"net", false},
+	}
+
+	for _, tt := range tests {
+		err := CheckSysctl(tt.key)
+		if tt.valid && err != nil {
+			t.Errorf("Sysctl %s must be valid: %v", tt.key, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("Sysctl %s must be rejected", tt.key)
+		}
+	}
+}
+
+func TestSysctl_MergeSplit(t *testing.T) {
+	defaults := map[string]string{
+		"net.ipv4.ip_forward":         "1",
+		"net.ipv4.conf.all.rp_filter": "1",
+	}
+	override := map[string]string{
+		"net.ipv4.conf.all.rp_filter":  "0",
+		"net.ipv4.conf.eth1.rp_filter": "0",
+		"net.ipv6.conf.eth1.mtu":       "1400",
+	}
+
+	global, perInterface := SplitSysctls(MergeSysctls(defaults, override))
+	if len(global) != 2 || global["net.ipv4.conf.all.rp_filter"] != "0" {
+		t.Errorf("Unexpected global sysctls: %v", global)
+	}
+	if len(perInterface) != 1 || len(perInterface["eth1"]) != 2 {
+		t.Errorf("Unexpected interface sysctls: %v", perInterface)
+	}
+	if defaults["net.ipv4.conf.all.rp_filter"] != "1" {
+		t.Errorf("Default sysctls have been modified")
+	}
+}
@@ -0,0 +1,62 @@
+package link
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+	"time"
+
+	"github.com/vishvananda/netns"
+)
+
+// runInNetns calls fn with the thread moved to namespace, then moves
+// the thread back to its origin netns
+func runInNetns(namespace netns.NsHandle, fn func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	origin, err := netns.Get()
+	if err != nil {
+		return fmt.Errorf("unable to get current netns: %v", err)
+	}
+	defer origin.Close()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+	defer netns.Set(origin)
+
+	return fn()
+}
+
+// DialInNetns connects to address from namespace. The socket is created
+// in namespace, then the thread goes back to its netns since the
+// connection can be used by any goroutine
+func DialInNetns(namespace netns.NsHandle, network, address string, timeout time.Duration) (net.Conn, error) {
+	var conn net.Conn
+	err := runInNetns(namespace, func() (err error) {
+		conn, err = net.DialTimeout(network, address, timeout)
+		return err
+	})
+	return conn, err
+}
+
+// ListenInNetns announces a stream listener on address in namespace
+func ListenInNetns(namespace netns.NsHandle, network, address string) (net.Listener, error) {
+	var listener net.Listener
+	err := runInNetns(namespace, func() (err error) {
+		listener, err = net.Listen(network, address)
+		return err
+	})
+	return listener, err
+}
+
+// ListenPacketInNetns announces a packet listener on address in namespace
+func ListenPacketInNetns(namespace netns.NsHandle, network, address string) (net.PacketConn, error) {
+	var conn net.PacketConn
+	err := runInNetns(namespace, func() (err error) {
+		conn, err = net.ListenPacket(network, address)
+		return err
+	})
+	return conn, err
+}
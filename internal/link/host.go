@@ -0,0 +1,276 @@
+package link
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"runtime"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+// HostInterface is the state of a host interface before it is moved in
+// a node, used to restore it when the project is closed
+type HostInterface struct {
+	Name    string
+	Index   int
+	Mtu     int
+	Mac     net.HardwareAddr
+	PermMac net.HardwareAddr
+	Up      bool
+}
+
+func getHostTmpName(index int) string {
+	return fmt.Sprintf("ntmpt%d", index)
+}
+
+// moveBackLink moves the link currently named current in the netns in to
+// dest, renames it destName and sets it up if required. It is used to
+// rollback a failed move, so errors are ignored: the caller returns the
+// one of the move
+func moveBackLink(index int, current string, in netns.NsHandle, dest netns.NsHandle, destName string, up bool) {
+	if err := netns.Set(in); err != nil {
+		return
+	}
+	link, err := netlink.LinkByName(current)
+	if err != nil {
+		return
+	}
+
+	if in != dest {
+		tmpName := getHostTmpName(index)
+		netlink.LinkSetDown(link)
+		if current != tmpName {
+			if err := netlink.LinkSetName(link, tmpName); err != nil {
+				return
+			}
+		}
+		if err := netlink.LinkSetNsFd(link, int(dest)); err != nil {
+			return
+		}
+		if err := netns.Set(dest); err != nil {
+			return
+		}
+		if link, err = netlink.LinkByName(tmpName); err != nil {
+			return
+		}
+		current = tmpName
+	}
+
+	if current != destName {
+		netlink.LinkSetName(link, destName)
+	}
+	if up {
+		netlink.LinkSetUp(link)
+	}
+}
+
+// MoveHostInterface moves the interface name of namespace in target and
+// renames it targetName. The interface is renamed before the move to
+// avoid a conflict with an interface of the target netns
+func MoveHostInterface(name string, namespace netns.NsHandle, target netns.NsHandle, targetName string) (hostIf *HostInterface, err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	hostIf = &HostInterface{
+		Name:    name,
+		Index:   link.Attrs().Index,
+		Mtu:     link.Attrs().MTU,
+		Mac:     link.Attrs().HardwareAddr,
+		PermMac: link.Attrs().PermHWAddr,
+		Up:      link.Attrs().Flags&net.FlagUp != 0,
+	}
+
+	// on error, the interface is given back to the host as it was
+	current, currentNs := name, namespace
+	index, up := hostIf.Index, hostIf.Up
+	defer func() {
+		if err != nil {
+			moveBackLink(index, current, currentNs, namespace, name, up)
+		}
+	}()
+
+	tmpName := getHostTmpName(hostIf.Index)
+	if err := netlink.LinkSetDown(link); err != nil {
+		return nil, fmt.Errorf("error when set %s down: %v", name, err)
+	}
+	if err := netlink.LinkSetName(link, tmpName); err != nil {
+		return nil, fmt.Errorf("error when renaming link %s->%s: %v", name, tmpName, err)
+	}
+	current = tmpName
+	if err := netlink.LinkSetNsFd(link, int(target)); err != nil {
+		return nil, fmt.Errorf("error when update netns for %s: %v", name, err)
+	}
+	currentNs = target
+
+	if err := netns.Set(target); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+	if link, err = netlink.LinkByName(tmpName); err != nil {
+		return nil, fmt.Errorf("unable get link %s: %v", tmpName, err)
+	}
+	if err := netlink.LinkSetName(link, targetName); err != nil {
+		return nil, fmt.Errorf("error when renaming link %s->%s: %v", tmpName, targetName, err)
+	}
+	current = targetName
+	if err := netlink.LinkSetUp(link); err != nil {
+		return nil, fmt.Errorf("error when set %s up: %v", targetName, err)
+	}
+
+	return hostIf, nil
+}
+
+// FindHostInterface returns the current name in namespace of a host
+// interface moved with MoveHostInterface. It is used when the netns of
+// the node has been destroyed, the kernel moves back physical interfaces
+// in the root netns without restoring their name
+func FindHostInterface(hostIf *HostInterface, namespace netns.NsHandle) (string, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return "", fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByIndex(hostIf.Index)
+	if err != nil {
+		return "", fmt.Errorf("host interface %s not found: %v", hostIf.Name, err)
+	}
+	if len(hostIf.PermMac) > 0 && !bytes.Equal(link.Attrs().PermHWAddr, hostIf.PermMac) {
+		return "", fmt.Errorf("host interface %s not found", hostIf.Name)
+	}
+
+	return link.Attrs().Name, nil
+}
+
+// RestoreHostInterface moves back the host interface, currently named
+// name in current, in namespace with its name, MTU, MAC address and state
+func RestoreHostInterface(hostIf *HostInterface, name string, current netns.NsHandle, namespace netns.NsHandle) (err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(current); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	// on error, the interface keeps its name and state in the netns
+	// where it is, so the restore can be done again
+	curName, curNs := name, current
+	destName, destNs := name, current
+	up := link.Attrs().Flags&net.FlagUp != 0
+	defer func() {
+		if err != nil {
+			moveBackLink(hostIf.Index, curName, curNs, destNs, destName, up)
+		}
+	}()
+
+	if err := netlink.LinkSetDown(link); err != nil {
+		return fmt.Errorf("error when set %s down: %v", name, err)
+	}
+	if link.Attrs().MTU != hostIf.Mtu {
+		if err := netlink.LinkSetMTU(link, hostIf.Mtu); err != nil {
+			return fmt.Errorf("error when set mtu of %s: %v", name, err)
+		}
+	}
+	if !bytes.Equal(link.Attrs().HardwareAddr, hostIf.Mac) {
+		if err := netlink.LinkSetHardwareAddr(link, hostIf.Mac); err != nil {
+			return fmt.Errorf("error when set MAC address of %s: %v", name, err)
+		}
+	}
+
+	tmpName := getHostTmpName(hostIf.Index)
+	if err := netlink.LinkSetName(link, tmpName); err != nil {
+		return fmt.Errorf("error when renaming link %s->%s: %v", name, tmpName, err)
+	}
+	curName = tmpName
+	if err := netlink.LinkSetNsFd(link, int(namespace)); err != nil {
+		return fmt.Errorf("error when update netns for %s: %v", name, err)
+	}
+	// the interface is back in the host, finish the restore there
+	curNs, destNs = namespace, namespace
+	destName, up = hostIf.Name, hostIf.Up
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+	if link, err = netlink.LinkByName(tmpName); err != nil {
+		return fmt.Errorf("unable get link %s: %v", tmpName, err)
+	}
+	if err := netlink.LinkSetName(link, hostIf.Name); err != nil {
+		return fmt.Errorf("error when renaming link %s->%s: %v", tmpName, hostIf.Name, err)
+	}
+	if hostIf.Up {
+		if err := netlink.LinkSetUp(link); err != nil {
+			return fmt.Errorf("error when set %s up: %v", hostIf.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateHostChild creates a macvlan (bridge mode) or an ipvlan (l2 mode)
+// interface on top of the interface parent of parentNs, directly in
+// namespace. The MAC address is ignored for ipvlan, which shares the
+// address of its parent
+func CreateHostChild(name string, kind string, parent string, parentNs netns.NsHandle, namespace netns.NsHandle, mac net.HardwareAddr, mtu int) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(parentNs); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	parentLink, err := netlink.LinkByName(parent)
+	if err != nil {
+		return fmt.Errorf("unable to find %s parent %s: %v", kind, parent, err)
+	}
+
+	la := netlink.NewLinkAttrs()
+	la.Name = name
+	la.Namespace = netlink.NsFd(namespace)
+	la.ParentIndex = parentLink.Attrs().Index
+	la.MTU = mtu
+
+	var child netlink.Link
+	switch kind {
+	case "macvlan":
+		la.HardwareAddr = mac
+		child = &netlink.Macvlan{LinkAttrs: la, Mode: netlink.MACVLAN_MODE_BRIDGE}
+	case "ipvlan":
+		child = &netlink.IPVlan{LinkAttrs: la, Mode: netlink.IPVLAN_MODE_L2}
+	default:
+		return fmt.Errorf("unknown host child kind %s", kind)
+	}
+
+	if err := netlink.LinkAdd(child); err != nil {
+		return fmt.Errorf("error when creating %s %s: %v", kind, name, err)
+	}
+
+	// the index of child is not valid, it is resolved in parentNs
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+	if child, err = netlink.LinkByName(name); err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+	if err := netlink.LinkSetUp(child); err != nil {
+		return fmt.Errorf("error when set %s up: %v", name, err)
+	}
+
+	return nil
+}
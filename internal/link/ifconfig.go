@@ -0,0 +1,244 @@
+package link
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+)
+
+// InterfaceConfig is the static configuration of an interface, as read
+// from the kernel
+type InterfaceConfig struct {
+	Name      string
+	Mtu       int
+	Mac       string
+	Alias     string
+	Addresses []string
+}
+
+// Route is a static route of the main table, Destination is "default"
+// for the default route
+type Route struct {
+	Destination string
+	Gateway     string
+	Device      string
+}
+
+func SetInterfaceMTU(name string, namespace netns.NsHandle, mtu int) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	if err := netlink.LinkSetMTU(link, mtu); err != nil {
+		return fmt.Errorf("error when set mtu of %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func SetInterfaceMAC(name string, namespace netns.NsHandle, mac string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return fmt.Errorf("unable to parse MAC address %s: %v", mac, err)
+	}
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	if err := netlink.LinkSetHardwareAddr(link, hwAddr); err != nil {
+		return fmt.Errorf("error when set MAC address of %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// IpAddressReplace adds the IP address to the interface, without error if
+// it is already set
+func IpAddressReplace(ifName string, namespace netns.NsHandle, IPAddress string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(ifName)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", ifName, err)
+	}
+
+	addr, err := netlink.ParseAddr(IPAddress)
+	if err != nil {
+		return fmt.Errorf("unable to parse IP address %s: %v", IPAddress, err)
+	}
+
+	if err := netlink.AddrReplace(link, addr); err != nil {
+		return fmt.Errorf("unable to add IP address %s to link %s: %v", IPAddress, ifName, err)
+	}
+
+	return nil
+}
+
+// GetInterfaceConfig returns the configuration of an interface, only
+// permanent addresses with global scope are returned (no link-local
+// or autoconfigured addresses)
+func GetInterfaceConfig(name string, namespace netns.NsHandle) (*InterfaceConfig, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list addresses of %s: %v", name, err)
+	}
+
+	config := &InterfaceConfig{
+		Name:      name,
+		Mtu:       link.Attrs().MTU,
+		Mac:       link.Attrs().HardwareAddr.String(),
+		Alias:     link.Attrs().Alias,
+		Addresses: make([]string, 0),
+	}
+	for _, addr := range addrs {
+		if addr.Scope != unix.RT_SCOPE_UNIVERSE || addr.Flags&unix.IFA_F_PERMANENT == 0 {
+			continue
+		}
+		config.Addresses = append(config.Addresses, addr.IPNet.String())
+	}
+
+	return config, nil
+}
+
+func RouteReplace(namespace netns.NsHandle, route Route) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	nlRoute := &netlink.Route{Protocol: unix.RTPROT_STATIC}
+	if route.Gateway != "" {
+		nlRoute.Gw = net.ParseIP(route.Gateway)
+		if nlRoute.Gw == nil {
+			return fmt.Errorf("unable to parse gateway %s", route.Gateway)
+		}
+	}
+
+	if route.Destination == "default" {
+		if nlRoute.Gw != nil && nlRoute.Gw.To4() == nil {
+			_, nlRoute.Dst, _ = net.ParseCIDR("::/0")
+		} else {
+			_, nlRoute.Dst, _ = net.ParseCIDR("0.0.0.0/0")
+		}
+	} else {
+		_, dst, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return fmt.Errorf("unable to parse route destination %s: %v", route.Destination, err)
+		}
+		nlRoute.Dst = dst
+	}
+
+	if route.Device != "" {
+		link, err := netlink.LinkByName(route.Device)
+		if err != nil {
+			return fmt.Errorf("unable get link %s: %v", route.Device, err)
+		}
+		nlRoute.LinkIndex = link.Attrs().Index
+	}
+
+	if err := netlink.RouteReplace(nlRoute); err != nil {
+		return fmt.Errorf("unable to add route to %s: %v", route.Destination, err)
+	}
+
+	return nil
+}
+
+// IsGatewayReachable returns true if gateway is on a network directly
+// connected to the namespace, so a route through it can be added
+func IsGatewayReachable(namespace netns.NsHandle, gateway string) bool {
+	ip := net.ParseIP(gateway)
+	if ip == nil {
+		return false
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return false
+	}
+
+	nlRoutes, err := netlink.RouteGet(ip)
+	return err == nil && len(nlRoutes) > 0 && nlRoutes[0].Gw == nil
+}
+
+// GetStaticRoutes returns routes of the main table added by an user or
+// by RouteReplace, routes of routing daemons are not returned
+func GetStaticRoutes(namespace netns.NsHandle) ([]Route, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	nlRoutes, err := netlink.RouteList(nil, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list routes: %v", err)
+	}
+
+	routes := make([]Route, 0)
+	for _, nlRoute := range nlRoutes {
+		if nlRoute.Protocol != unix.RTPROT_STATIC && nlRoute.Protocol != unix.RTPROT_BOOT {
+			continue
+		}
+		if nlRoute.Type != unix.RTN_UNICAST || len(nlRoute.MultiPath) > 0 {
+			continue
+		}
+
+		route := Route{Destination: "default"}
+		if nlRoute.Dst != nil {
+			if ones, _ := nlRoute.Dst.Mask.Size(); ones > 0 {
+				route.Destination = nlRoute.Dst.String()
+			}
+		}
+		if nlRoute.Gw != nil {
+			route.Gateway = nlRoute.Gw.String()
+		} else if link, err := netlink.LinkByIndex(nlRoute.LinkIndex); err == nil {
+			route.Device = link.Attrs().Name
+		}
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
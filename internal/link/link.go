@@ -1,6 +1,7 @@
 package link
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"net"
 	"os"
@@ -18,6 +19,33 @@ const (
 	IFSTATE_DOWN
 )
 
+const (
+	DefaultMTU = 1500
+)
+
+// BondOptions are the options of a link aggregation, Mode is 802.3ad
+// (default), active-backup or balance-xor and LacpRate slow (default)
+// or fast
+type BondOptions struct {
+	Mode     string
+	LacpRate string
+}
+
+func (o BondOptions) GetMode() string {
+	if o.Mode == "" {
+		return "802.3ad"
+	}
+	return o.Mode
+}
+
+// VethOptions are the optional attributes of a veth pair, DefaultMTU is
+// used if MTU is 0 and the kernel picks random MAC addresses if they are nil
+type VethOptions struct {
+	MTU     int
+	MAC     net.HardwareAddr
+	PeerMAC net.HardwareAddr
+}
+
 var (
 	mutex = &sync.Mutex{}
 )
@@ -37,21 +65,28 @@ func IsLinkExist(name string, namespace netns.NsHandle) bool {
 	return err == nil
 }
 
-func CreateVethLink(name string, namespace netns.NsHandle, peerName string, peerNamespace netns.NsHandle) (*netlink.Veth, error) {
+func CreateVethLink(name string, namespace netns.NsHandle, peerName string, peerNamespace netns.NsHandle, opts VethOptions) (*netlink.Veth, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
 	netns.Set(namespace)
 
+	mtu := opts.MTU
+	if mtu == 0 {
+		mtu = DefaultMTU
+	}
+
 	veth := &netlink.Veth{
 		LinkAttrs: netlink.LinkAttrs{
-			Name:      name,
-			MTU:       1500,
-			TxQLen:    1000,
-			Namespace: netlink.NsFd(namespace),
+			Name:         name,
+			MTU:          mtu,
+			TxQLen:       1000,
+			HardwareAddr: opts.MAC,
+			Namespace:    netlink.NsFd(namespace),
 		},
-		PeerName:      peerName,
-		PeerNamespace: netlink.NsFd(peerNamespace),
+		PeerName:         peerName,
+		PeerHardwareAddr: opts.PeerMAC,
+		PeerNamespace:    netlink.NsFd(peerNamespace),
 	}
 
 	if err := netlink.LinkAdd(veth); err != nil {
@@ -61,6 +96,17 @@ func CreateVethLink(name string, namespace netns.NsHandle, peerName string, peer
 	return veth, nil
 }
 
+// GenerateMAC returns a locally administered unicast MAC address derived
+// from the seed, so an interface keeps the same address between runs
+func GenerateMAC(seed string) net.HardwareAddr {
+	sum := sha256.Sum256([]byte(seed))
+
+	mac := make(net.HardwareAddr, 6)
+	mac[0] = 0x02
+	copy(mac[1:], sum[:5])
+	return mac
+}
+
 func CreateNetns(name string) (netns.NsHandle, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -133,6 +179,96 @@ func CreateVRRPMacVlan(name string, parent string, group int, namespace netns.Ns
 	return CreateMacVlan(name, parent, peerMAC, netlink.MACVLAN_MODE_BRIDGE, namespace)
 }
 
+func CreateVlan(name string, parent string, id int, namespace netns.NsHandle) (*netlink.Vlan, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	netns.Set(namespace)
+	parentLink, err := netlink.LinkByName(parent)
+	if err != nil {
+		return &netlink.Vlan{}, fmt.Errorf("unable to find vlan parent %s: %v", parent, err)
+	}
+
+	la := netlink.NewLinkAttrs()
+	la.Name = name
+	la.Namespace = netlink.NsFd(namespace)
+	la.ParentIndex = parentLink.Attrs().Index
+	vlan := &netlink.Vlan{
+		LinkAttrs:    la,
+		VlanId:       id,
+		VlanProtocol: netlink.VLAN_PROTOCOL_8021Q,
+	}
+
+	if err := netlink.LinkAdd(vlan); err != nil {
+		return vlan, fmt.Errorf("error when creating VLAN %s: %v", name, err)
+	}
+	return vlan, nil
+}
+
+// CreateBond creates the bond if it does not exist and enslaves the
+// members. Link monitoring is enabled so a member set down is detected
+func CreateBond(name string, members []string, opts BondOptions, namespace netns.NsHandle) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	bond, err := netlink.LinkByName(name)
+	if err != nil {
+		la := netlink.NewLinkAttrs()
+		la.Name = name
+		newBond := netlink.NewLinkBond(la)
+		newBond.Mode = netlink.StringToBondMode(opts.GetMode())
+		newBond.Miimon = 100
+		if newBond.Mode == netlink.BOND_MODE_802_3AD && opts.LacpRate != "" {
+			newBond.LacpRate = netlink.StringToBondLacpRate(opts.LacpRate)
+		}
+
+		if err := netlink.LinkAdd(newBond); err != nil {
+			return fmt.Errorf("error when creating bond %s: %v", name, err)
+		}
+		if bond, err = netlink.LinkByName(name); err != nil {
+			return fmt.Errorf("unable get link %s: %v", name, err)
+		}
+	}
+
+	for idx, member := range members {
+		mLink, err := netlink.LinkByName(member)
+		if err != nil {
+			return fmt.Errorf("unable get link %s: %v", member, err)
+		}
+		// enslaved links get the MTU of the bond, so the bond takes
+		// the MTU of its members (ie. jumbo frames of the links)
+		if idx == 0 && bond.Attrs().MTU != mLink.Attrs().MTU {
+			if err := netlink.LinkSetMTU(bond, mLink.Attrs().MTU); err != nil {
+				return fmt.Errorf("unable to set MTU of bond %s: %v", name, err)
+			}
+		}
+		if mLink.Attrs().MasterIndex == bond.Attrs().Index {
+			continue
+		}
+
+		// a link has to be down to be enslaved
+		if err := netlink.LinkSetDown(mLink); err != nil {
+			return fmt.Errorf("error when set %s down: %v", member, err)
+		}
+		if err := netlink.LinkSetMasterByIndex(mLink, bond.Attrs().Index); err != nil {
+			return fmt.Errorf("unable to add %s in bond %s: %v", member, name, err)
+		}
+		if err := netlink.LinkSetUp(mLink); err != nil {
+			return fmt.Errorf("error when set %s up: %v", member, err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(bond); err != nil {
+		return fmt.Errorf("error when set %s up: %v", name, err)
+	}
+
+	return nil
+}
+
 func CreateVrf(name string, namespace netns.NsHandle, table int) (*netlink.Vrf, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -156,6 +292,26 @@ func CreateVrf(name string, namespace netns.NsHandle, table int) (*netlink.Vrf,
 	return vrf, nil
 }
 
+func GetBridge(name string, namespace netns.NsHandle) (*netlink.Bridge, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	lk, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	br, ok := lk.(*netlink.Bridge)
+	if !ok {
+		return nil, fmt.Errorf("link %s is not a bridge", name)
+	}
+	return br, nil
+}
+
 func AttachToBridge(br *netlink.Bridge, ifName string, namespace netns.NsHandle) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -232,6 +388,47 @@ func SetInterfaceState(name string, namespace netns.NsHandle, state IfState) err
 	return nil
 }
 
+func SetInterfaceAlias(name string, namespace netns.NsHandle, alias string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	if err := netlink.LinkSetAlias(link, alias); err != nil {
+		return fmt.Errorf("error when set alias of %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func GetInterfaceStatistics(name string, namespace netns.NsHandle) (*netlink.LinkStatistics, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(namespace); err != nil {
+		return nil, fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	stats := link.Attrs().Statistics
+	if stats == nil {
+		return nil, fmt.Errorf("no statistics available for link %s", name)
+	}
+
+	return stats, nil
+}
+
 func SetLinkNetns(link netlink.Link, targetNs netns.NsHandle) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -1,13 +1,16 @@
 package link
 
 import (
+	"net"
 	"os"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/mroy31/gonetem/internal/utils"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
 )
 
 func skipUnlessRoot(t *testing.T) {
@@ -47,7 +50,7 @@ func TestLink_CreateVeth(t *testing.T) {
 	ns, teardown := setUpNetlinkTest(t)
 	defer teardown()
 
-	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns)
+	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, VethOptions{})
 	if err != nil {
 		t.Fatalf("Unable to create veth: %v", err)
 	}
@@ -56,6 +59,46 @@ func TestLink_CreateVeth(t *testing.T) {
 	checkLinkExistence(t, veth.Name, veth.PeerName)
 }
 
+func TestLink_CreateVethOptions(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	opts := VethOptions{
+		MTU:     9000,
+		MAC:     GenerateMAC("veth"),
+		PeerMAC: GenerateMAC("peer"),
+	}
+	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, opts)
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth)
+
+	peer, err := netlink.LinkByName(veth.PeerName)
+	if err != nil {
+		t.Fatalf("Unable to find peer of veth: %v", err)
+	}
+	if peer.Attrs().MTU != opts.MTU {
+		t.Errorf("Wrong MTU for peer %d != %d", peer.Attrs().MTU, opts.MTU)
+	}
+	if peer.Attrs().HardwareAddr.String() != opts.PeerMAC.String() {
+		t.Errorf("Wrong MAC for peer %s != %s", peer.Attrs().HardwareAddr, opts.PeerMAC)
+	}
+}
+
+func TestLink_GenerateMAC(t *testing.T) {
+	mac := GenerateMAC("prj/R1/0")
+	if mac.String() != GenerateMAC("prj/R1/0").String() {
+		t.Errorf("Generated MAC must be stable")
+	}
+	if mac.String() == GenerateMAC("prj/R1/1").String() {
+		t.Errorf("Generated MAC must depend on the seed")
+	}
+	if mac[0]&0x01 != 0 || mac[0]&0x02 == 0 {
+		t.Errorf("Generated MAC %s must be a locally administered unicast address", mac)
+	}
+}
+
 func TestLink_CreateBridge(t *testing.T) {
 	ns, teardown := setUpNetlinkTest(t)
 	defer teardown()
@@ -88,6 +131,96 @@ func TestLink_CreateVRRPMacvlan(t *testing.T) {
 	checkLinkExistence(t, macvlan.Name)
 }
 
+func TestLink_CreateVlan(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	parent := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "foo"}}
+	if err := netlink.LinkAdd(parent); err != nil {
+		t.Fatal(err)
+	}
+	defer netlink.LinkDel(parent)
+
+	vlan, err := CreateVlan("foo.10", "foo", 10, ns)
+	if err != nil {
+		t.Fatalf("Unable to create vlan: %v", err)
+	}
+	defer netlink.LinkDel(vlan)
+
+	checkLinkExistence(t, vlan.Name)
+}
+
+func TestLink_CreateBond(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, VethOptions{})
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth)
+
+	name := utils.RandString(6)
+	opts := BondOptions{Mode: "active-backup"}
+	if err := CreateBond(name, []string{veth.Name}, opts, ns); err != nil {
+		t.Fatalf("Unable to create bond: %v", err)
+	}
+	// a second call must not fail
+	if err := CreateBond(name, []string{veth.Name}, opts, ns); err != nil {
+		t.Fatalf("Unable to update bond: %v", err)
+	}
+
+	bond, err := netlink.LinkByName(name)
+	if err != nil {
+		t.Fatalf("Unable to find created bond: %v", err)
+	}
+	defer netlink.LinkDel(bond)
+
+	member, _ := netlink.LinkByName(veth.Name)
+	if member.Attrs().MasterIndex != bond.Attrs().Index {
+		t.Errorf("Link %s is not a member of bond %s", veth.Name, name)
+	}
+}
+
+func TestLink_CreateBondMTU(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	opts := VethOptions{MTU: 9000}
+	veth1, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, opts)
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth1)
+	veth2, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, opts)
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth2)
+
+	name := utils.RandString(6)
+	bondOpts := BondOptions{Mode: "802.3ad"}
+	if err := CreateBond(name, []string{veth1.Name, veth2.Name}, bondOpts, ns); err != nil {
+		t.Fatalf("Unable to create bond: %v", err)
+	}
+
+	bond, err := netlink.LinkByName(name)
+	if err != nil {
+		t.Fatalf("Unable to find created bond: %v", err)
+	}
+	defer netlink.LinkDel(bond)
+
+	if bond.Attrs().MTU != opts.MTU {
+		t.Errorf("Wrong MTU for bond %d != %d", bond.Attrs().MTU, opts.MTU)
+	}
+	for _, member := range []string{veth1.Name, veth2.Name} {
+		mLink, _ := netlink.LinkByName(member)
+		if mLink.Attrs().MTU != opts.MTU {
+			t.Errorf("Wrong MTU for member %s %d != %d", member, mLink.Attrs().MTU, opts.MTU)
+		}
+	}
+}
+
 func TestLink_CreateVrf(t *testing.T) {
 	ns, teardown := setUpNetlinkTest(t)
 	defer teardown()
@@ -105,7 +238,7 @@ func TestLink_InterfaceState(t *testing.T) {
 	ns, teardown := setUpNetlinkTest(t)
 	defer teardown()
 
-	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns)
+	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, VethOptions{})
 	if err != nil {
 		t.Fatalf("Unable to create veth: %v", err)
 	}
@@ -118,3 +251,296 @@ func TestLink_InterfaceState(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 }
+
+func TestLink_MoveHostInterface(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	target, err := netns.New()
+	if err != nil {
+		t.Fatalf("Unable to create target netns: %v", err)
+	}
+	defer target.Close()
+	if err := netns.Set(ns); err != nil {
+		t.Fatal(err)
+	}
+
+	hostName := utils.RandString(6)
+	veth, err := CreateVethLink(hostName, ns, utils.RandString(6), ns, VethOptions{MTU: 1400})
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth)
+	if err := SetInterfaceState(hostName, ns, IFSTATE_UP); err != nil {
+		t.Fatal(err)
+	}
+
+	hostIf, err := MoveHostInterface(hostName, ns, target, "eth3")
+	if err != nil {
+		t.Fatalf("Unable to move host interface: %v", err)
+	}
+	if !IsLinkExist("eth3", target) || IsLinkExist(hostName, ns) {
+		t.Fatalf("Host interface has not been moved")
+	}
+
+	if err := SetInterfaceMTU("eth3", target, 1300); err != nil {
+		t.Fatal(err)
+	}
+	if err := RestoreHostInterface(hostIf, "eth3", target, ns); err != nil {
+		t.Fatalf("Unable to restore host interface: %v", err)
+	}
+
+	netns.Set(ns)
+	lk, err := netlink.LinkByName(hostName)
+	if err != nil {
+		t.Fatalf("Host interface has not been restored: %v", err)
+	}
+	if lk.Attrs().MTU != 1400 || lk.Attrs().Flags&net.FlagUp == 0 {
+		t.Errorf("Host interface state has not been restored (mtu %d)", lk.Attrs().MTU)
+	}
+}
+
+func TestLink_MoveHostInterfaceRollback(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	target, err := netns.New()
+	if err != nil {
+		t.Fatalf("Unable to create target netns: %v", err)
+	}
+	defer target.Close()
+
+	// the target name is already used in the target netns
+	conflict, err := CreateVethLink("eth3", target, utils.RandString(6), target, VethOptions{})
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer func() {
+		netns.Set(target)
+		netlink.LinkDel(conflict)
+	}()
+	if err := netns.Set(ns); err != nil {
+		t.Fatal(err)
+	}
+
+	hostName := utils.RandString(6)
+	veth, err := CreateVethLink(hostName, ns, utils.RandString(6), ns, VethOptions{})
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth)
+	if err := SetInterfaceState(hostName, ns, IFSTATE_UP); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MoveHostInterface(hostName, ns, target, "eth3"); err == nil {
+		t.Fatalf("Move of host interface must fail")
+	}
+
+	netns.Set(ns)
+	lk, err := netlink.LinkByName(hostName)
+	if err != nil {
+		t.Fatalf("Host interface has not been given back: %v", err)
+	}
+	if lk.Attrs().Flags&net.FlagUp == 0 {
+		t.Errorf("Host interface has not been set up again")
+	}
+}
+
+func TestLink_CreateVxlan(t *testing.T) {
+	underlay, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	lo, err := netlink.LinkByName("lo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := netlink.LinkSetUp(lo); err != nil {
+		t.Fatal(err)
+	}
+
+	// two ends of the tunnel in the same underlay, with different ports
+	ns1, err := netns.New()
+	if err != nil {
+		t.Fatalf("Unable to create netns: %v", err)
+	}
+	defer ns1.Close()
+	ns2, err := netns.New()
+	if err != nil {
+		t.Fatalf("Unable to create netns: %v", err)
+	}
+	defer ns2.Close()
+
+	local := net.ParseIP("127.0.0.1")
+	opts1 := VxlanOptions{Vni: 100, Local: local, Port: 4789, Remote: local, RemoteVni: 200, RemotePort: 4790}
+	if err := CreateVxlan("eth0", opts1, underlay, ns1); err != nil {
+		t.Fatalf("Unable to create vxlan: %v", err)
+	}
+	opts2 := VxlanOptions{Vni: 200, Local: local, Port: 4790, Remote: local, RemoteVni: 100, RemotePort: 4789}
+	if err := CreateVxlan("eth0", opts2, underlay, ns2); err != nil {
+		t.Fatalf("Unable to create vxlan: %v", err)
+	}
+
+	netns.Set(ns1)
+	lk, err := netlink.LinkByName("eth0")
+	if err != nil {
+		t.Fatalf("Unable to find vxlan: %v", err)
+	}
+	vxlan, ok := lk.(*netlink.Vxlan)
+	if !ok || vxlan.VxlanId != 100 || vxlan.Port != 4789 {
+		t.Errorf("Unexpected vxlan link: %v", lk)
+	}
+
+	fdb, err := netlink.NeighList(lk.Attrs().Index, unix.AF_BRIDGE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fdb) != 1 || !fdb[0].IP.Equal(local) || fdb[0].VNI != 200 {
+		t.Errorf("Unexpected vxlan destination: %v", fdb)
+	}
+}
+
+func TestLink_DialInNetns(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	lo, err := netlink.LinkByName("lo")
+	if err != nil {
+		t.Fatalf("Unable to get lo: %v", err)
+	}
+	if err := netlink.LinkSetUp(lo); err != nil {
+		t.Fatalf("Unable to set lo up: %v", err)
+	}
+
+	// the listener is only reachable from the test netns
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unable to listen: %v", err)
+	}
+	defer listener.Close()
+
+	rootNs := GetRootNetns()
+	defer rootNs.Close()
+	if err := netns.Set(rootNs); err != nil {
+		t.Fatalf("Unable to switch to root netns: %v", err)
+	}
+	defer netns.Set(ns)
+
+	conn, err := DialInNetns(ns, "tcp", listener.Addr().String(), time.Second)
+	if err != nil {
+		t.Fatalf("Unable to dial in netns: %v", err)
+	}
+	defer conn.Close()
+
+	current, err := netns.Get()
+	if err != nil {
+		t.Fatalf("Unable to get current netns: %v", err)
+	}
+	defer current.Close()
+	if !current.Equal(rootNs) {
+		t.Errorf("Thread has not been moved back to its netns")
+	}
+
+	peer, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("Unable to accept connection: %v", err)
+	}
+	defer peer.Close()
+
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatalf("Unable to write: %v", err)
+	}
+	buf := make([]byte, 4)
+	peer.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := peer.Read(buf); err != nil || string(buf) != "ping" {
+		t.Errorf("Unexpected data received: %q (%v)", buf, err)
+	}
+}
+
+func TestLink_ListenInNetns(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	lo, err := netlink.LinkByName("lo")
+	if err != nil {
+		t.Fatalf("Unable to get lo: %v", err)
+	}
+	if err := netlink.LinkSetUp(lo); err != nil {
+		t.Fatalf("Unable to set lo up: %v", err)
+	}
+
+	rootNs := GetRootNetns()
+	defer rootNs.Close()
+	if err := netns.Set(rootNs); err != nil {
+		t.Fatalf("Unable to switch to root netns: %v", err)
+	}
+	defer netns.Set(ns)
+
+	listener, err := ListenInNetns(ns, "tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unable to listen in netns: %v", err)
+	}
+	defer listener.Close()
+
+	packetConn, err := ListenPacketInNetns(ns, "udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unable to listen packet in netns: %v", err)
+	}
+	defer packetConn.Close()
+
+	current, err := netns.Get()
+	if err != nil {
+		t.Fatalf("Unable to get current netns: %v", err)
+	}
+	defer current.Close()
+	if !current.Equal(rootNs) {
+		t.Errorf("Thread has not been moved back to its netns")
+	}
+
+	// both sockets are only reachable from the test netns
+	conn, err := DialInNetns(ns, "tcp", listener.Addr().String(), time.Second)
+	if err != nil {
+		t.Fatalf("Unable to dial listener in netns: %v", err)
+	}
+	conn.Close()
+
+	conn, err = DialInNetns(ns, "udp", packetConn.LocalAddr().String(), time.Second)
+	if err != nil {
+		t.Fatalf("Unable to dial packet conn in netns: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatalf("Unable to write: %v", err)
+	}
+	buf := make([]byte, 4)
+	packetConn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, _, err := packetConn.ReadFrom(buf); err != nil || string(buf) != "ping" {
+		t.Errorf("Unexpected data received: %q (%v)", buf, err)
+	}
+}
+
+func TestLink_IsGatewayReachable(t *testing.T) {
+	ns, teardown := setUpNetlinkTest(t)
+	defer teardown()
+
+	veth, err := CreateVethLink(utils.RandString(6), ns, utils.RandString(6), ns, VethOptions{})
+	if err != nil {
+		t.Fatalf("Unable to create veth: %v", err)
+	}
+	defer netlink.LinkDel(veth)
+
+	if err := SetInterfaceState(veth.Name, ns, IFSTATE_UP); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := IpAddressReplace(veth.Name, ns, "192.168.100.1/24"); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if !IsGatewayReachable(ns, "192.168.100.254") {
+		t.Errorf("Gateway on a connected network must be reachable")
+	}
+	if IsGatewayReachable(ns, "10.10.10.1") {
+		t.Errorf("Gateway without route must not be reachable")
+	}
+}
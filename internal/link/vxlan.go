@@ -0,0 +1,95 @@
+package link
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"runtime"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+)
+
+const DefaultVxlanPort = 4789
+
+// VxlanOptions describes a point to point VXLAN tunnel. Vni and Port are
+// used to receive packets, RemoteVni and RemotePort to send them, so the
+// two ends of a tunnel can run on the same host with different ports
+type VxlanOptions struct {
+	Vni        int
+	Local      net.IP
+	Port       int
+	Remote     net.IP
+	RemoteVni  int
+	RemotePort int
+	MTU        int
+	MAC        net.HardwareAddr
+}
+
+// CreateVxlan creates the VXLAN interface name in namespace. The tunnel
+// sockets are opened in underlayNs, where the device is created before
+// being moved
+func CreateVxlan(name string, opts VxlanOptions, underlayNs netns.NsHandle, namespace netns.NsHandle) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := netns.Set(underlayNs); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+
+	la := netlink.NewLinkAttrs()
+	la.Name = name
+	la.Namespace = netlink.NsFd(namespace)
+	la.MTU = opts.MTU
+	la.HardwareAddr = opts.MAC
+	vxlan := &netlink.Vxlan{
+		LinkAttrs: la,
+		VxlanId:   opts.Vni,
+		SrcAddr:   opts.Local,
+		Port:      opts.Port,
+		Learning:  false,
+	}
+
+	if err := netlink.LinkAdd(vxlan); err != nil {
+		return fmt.Errorf("error when creating VXLAN %s: %v", name, err)
+	}
+
+	if err := netns.Set(namespace); err != nil {
+		return fmt.Errorf("error when switching netns: %v", err)
+	}
+	lk, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("unable get link %s: %v", name, err)
+	}
+
+	// default destination of the tunnel, netlink.Neigh does not
+	// support the port attribute
+	req := nl.NewNetlinkRequest(unix.RTM_NEWNEIGH, unix.NLM_F_CREATE|unix.NLM_F_APPEND|unix.NLM_F_ACK)
+	req.AddData(&netlink.Ndmsg{
+		Family: unix.AF_BRIDGE,
+		Index:  uint32(lk.Attrs().Index),
+		State:  netlink.NUD_NOARP | netlink.NUD_PERMANENT,
+		Flags:  netlink.NTF_SELF,
+	})
+	remote := opts.Remote.To4()
+	if remote == nil {
+		remote = opts.Remote.To16()
+	}
+	port := make([]byte, 2)
+	binary.BigEndian.PutUint16(port, uint16(opts.RemotePort))
+	req.AddData(nl.NewRtAttr(netlink.NDA_LLADDR, make([]byte, 6)))
+	req.AddData(nl.NewRtAttr(netlink.NDA_DST, remote))
+	req.AddData(nl.NewRtAttr(netlink.NDA_PORT, port))
+	req.AddData(nl.NewRtAttr(netlink.NDA_VNI, nl.Uint32Attr(uint32(opts.RemoteVni))))
+	if _, err := req.Execute(unix.NETLINK_ROUTE, 0); err != nil {
+		return fmt.Errorf("unable to set destination of VXLAN %s: %v", name, err)
+	}
+
+	if err := netlink.LinkSetUp(lk); err != nil {
+		return fmt.Errorf("error when set %s up: %v", name, err)
+	}
+
+	return nil
+}
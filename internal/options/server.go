@@ -7,6 +7,7 @@ import (
 	"os"
 	"regexp"
 
+	"github.com/docker/go-units"
 	"google.golang.org/grpc/credentials"
 	"sigs.k8s.io/yaml"
 )
@@ -180,11 +181,65 @@ type DockerNodeOptions struct {
 	Tso bool
 }
 
+// DockerNodeResources describes the resource constraints of a container,
+// zero values mean no limit
+type DockerNodeResources struct {
+	Cpus   float64 `yaml:",omitempty"`
+	Memory string  `yaml:",omitempty"` // docker format, ie. 512m, 1g
+	Pids   int64   `yaml:",omitempty"`
+	Cpuset string  `yaml:",omitempty"`
+}
+
+func (r DockerNodeResources) IsEmpty() bool {
+	return r.Cpus == 0 && r.Memory == "" && r.Pids == 0 && r.Cpuset == ""
+}
+
+// Merge returns r with every non-zero field of override applied on it
+func (r DockerNodeResources) Merge(override DockerNodeResources) DockerNodeResources {
+	if override.Cpus != 0 {
+		r.Cpus = override.Cpus
+	}
+	if override.Memory != "" {
+		r.Memory = override.Memory
+	}
+	if override.Pids != 0 {
+		r.Pids = override.Pids
+	}
+	if override.Cpuset != "" {
+		r.Cpuset = override.Cpuset
+	}
+	return r
+}
+
+// GetMemory returns the memory limit in bytes
+func (r DockerNodeResources) GetMemory() (int64, error) {
+	if r.Memory == "" {
+		return 0, nil
+	}
+
+	memory, err := units.RAMInBytes(r.Memory)
+	if err != nil {
+		return 0, fmt.Errorf("memory '%s' is not valid: %w", r.Memory, err)
+	}
+	return memory, nil
+}
+
+// DockerReadinessProbe describes a command executed in the container
+// until it succeeds, before the node is linked and configured
+type DockerReadinessProbe struct {
+	Command  string
+	Interval int // in milliseconds
+	Timeout  int // in seconds
+}
+
 type DockerNodeConfig struct {
-	Type     string
-	Image    string
-	Volumes  []string
-	Commands struct {
+	Type      string
+	Image     string
+	Volumes   []string
+	Resources DockerNodeResources
+	Readiness DockerReadinessProbe
+	Sysctls   map[string]string
+	Commands  struct {
 		Console    string
 		Shell      string
 		LoadConfig []DockerConfigCommand
@@ -195,10 +250,27 @@ type DockerNodeConfig struct {
 	Options              DockerNodeOptions
 }
 
+// RemoteOptions configures the links with nodes of other gonetem servers,
+// built with VXLAN tunnels between Vtep addresses of the servers
+type RemoteOptions struct {
+	Vtep    string
+	Port    int
+	Servers map[string]string // name -> gRPC address of the server
+	// client certificate used to connect to the other servers when TLS
+	// is enabled, the server certificate is used if not set
+	Tls RemoteTLSOptions
+}
+
+type RemoteTLSOptions struct {
+	Cert string
+	Key  string
+}
+
 type NetemServerConfig struct {
 	Listen  string
 	Tls     TLSOptions
 	Workdir string
+	Remote  RemoteOptions
 	Docker  struct {
 		Timeoutop int
 		Nodes     struct {
@@ -209,6 +281,8 @@ type NetemServerConfig struct {
 		}
 		ExtraNodes []DockerNodeConfig
 		OvsImage   string
+		// total resources that can be requested by the nodes of each project
+		Budget DockerNodeResources
 	}
 }
 
@@ -261,3 +335,30 @@ func LoadServerTLSCredentials() (credentials.TransportCredentials, error) {
 
 	return credentials.NewTLS(config), nil
 }
+
+// LoadRemoteTLSCredentials returns the credentials used to connect to
+// remote servers. The client certificate is the one set in remote.tls or
+// the server certificate, it must be valid for client authentication since
+// remote servers verify it
+func LoadRemoteTLSCredentials() (credentials.TransportCredentials, error) {
+	tlsOptions := ServerConfig.Tls
+	if ServerConfig.Remote.Tls.Cert != "" {
+		tlsOptions.Cert = ServerConfig.Remote.Tls.Cert
+		tlsOptions.Key = ServerConfig.Remote.Tls.Key
+	}
+
+	certPool, clientCerts, err := loadTLSCerts(tlsOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkClientAuthUsage(clientCerts[0]); err != nil {
+		return nil, fmt.Errorf("certificate '%s': %w", tlsOptions.Cert, err)
+	}
+
+	config := &tls.Config{
+		Certificates: clientCerts,
+		RootCAs:      certPool,
+	}
+
+	return credentials.NewTLS(config), nil
+}
@@ -65,3 +65,25 @@ func TestOptions_ImageId(t *testing.T) {
 		t.Fatalf("Error: %s != mroy31/ovs-img:0.0.0", id)
 	}
 }
+
+func TestOptions_Resources(t *testing.T) {
+	defaults := DockerNodeResources{Cpus: 1, Memory: "512m"}
+	resources := defaults.Merge(DockerNodeResources{Memory: "1g", Pids: 100})
+
+	expected := DockerNodeResources{Cpus: 1, Memory: "1g", Pids: 100}
+	if resources != expected {
+		t.Fatalf("Error: %v != %v", resources, expected)
+	}
+
+	memory, err := resources.GetMemory()
+	if err != nil {
+		t.Fatalf("Unable to parse memory: %v", err)
+	}
+	if memory != 1024*1024*1024 {
+		t.Errorf("Error: %d != %d", memory, 1024*1024*1024)
+	}
+
+	if _, err := (DockerNodeResources{Memory: "wrong"}).GetMemory(); err == nil {
+		t.Errorf("An error is expected with a wrong memory value")
+	}
+}
@@ -36,3 +36,27 @@ func loadTLSCerts(tlsOptions TLSOptions) (*x509.CertPool, []tls.Certificate, err
 
 	return certPool, []tls.Certificate{cert}, nil
 }
+
+// checkClientAuthUsage returns an error if the extended key usages of the
+// certificate do not allow client authentication. A certificate without
+// extended key usage is valid for any usage
+func checkClientAuthUsage(cert tls.Certificate) error {
+	leaf := cert.Leaf
+	if leaf == nil {
+		var err error
+		if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return fmt.Errorf("unable to parse certificate: %w", err)
+		}
+	}
+
+	if len(leaf.ExtKeyUsage) == 0 {
+		return nil
+	}
+	for _, usage := range leaf.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth || usage == x509.ExtKeyUsageAny {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("clientAuth extended key usage is required to connect to remote servers, set remote.tls.cert/key")
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/mroy31/gonetem/internal/docker"
+	"github.com/mroy31/gonetem/internal/link"
 	"github.com/mroy31/gonetem/internal/options"
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netns"
@@ -107,6 +108,16 @@ func (o *OvsProjectInstance) Capture(ifName string, out io.Writer) error {
 	return client.ExecOutStream(context.Background(), o.containerId, cmd, out)
 }
 
+func (o *OvsProjectInstance) Logs(ctx context.Context, opts docker.LogsOptions, stdout, stderr io.Writer) error {
+	client, err := docker.NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Logs(ctx, o.containerId, opts, stdout, stderr)
+}
+
 func (o *OvsProjectInstance) Exec(cmd []string) error {
 	client, err := docker.NewDockerClient()
 	if err != nil {
@@ -168,7 +179,8 @@ func (o *OvsProjectInstance) DelBr(brName string) error {
 }
 
 func (o *OvsProjectInstance) AddPort(brName, ifName string) error {
-	cmd := []string{"ovs-vsctl", "add-port", brName, ifName}
+	// the port may still exist if the peer node has crashed
+	cmd := []string{"ovs-vsctl", "--may-exist", "add-port", brName, ifName}
 	return o.Exec(cmd)
 }
 
@@ -177,6 +189,30 @@ func (o *OvsProjectInstance) DelPort(brName, ifName string) error {
 	return o.Exec(cmd)
 }
 
+// AddBond replaces the ports of the members by a bond port
+func (o *OvsProjectInstance) AddBond(brName, bondName string, ifNames []string, opts link.BondOptions) error {
+	cmd := []string{"ovs-vsctl"}
+	for _, ifName := range ifNames {
+		cmd = append(cmd, "--if-exists", "del-port", brName, ifName, "--")
+	}
+	cmd = append(cmd, "--may-exist", "add-bond", brName, bondName)
+	cmd = append(cmd, ifNames...)
+
+	switch opts.GetMode() {
+	case "802.3ad":
+		cmd = append(cmd, "lacp=active", "bond_mode=balance-tcp")
+		if opts.LacpRate == "fast" {
+			cmd = append(cmd, "other_config:lacp-time=fast")
+		}
+	case "active-backup":
+		cmd = append(cmd, "bond_mode=active-backup")
+	default:
+		cmd = append(cmd, "bond_mode=balance-slb")
+	}
+
+	return o.Exec(cmd)
+}
+
 func (o *OvsProjectInstance) LoadConfig(name, brName, confPath string, timeout int) ([]string, error) {
 	var messages []string
 
@@ -297,7 +333,9 @@ func NewOvsInstance(prjID string) (*OvsProjectInstance, error) {
 		"ovs",
 		[]string{},
 		false,
-		false)
+		false,
+		nil,
+		options.DockerNodeResources{})
 	if err != nil {
 		return nil, err
 	}
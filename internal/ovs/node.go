@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/moby/term"
@@ -24,9 +25,25 @@ type OvsNode struct {
 	Running     bool
 	OvsInstance *OvsProjectInstance
 	Interfaces  map[string]link.IfState
+	Bonds       map[string]OvsBond
 	Logger      *logrus.Entry
 }
 
+// OvsBond is a link aggregation port of the switch
+type OvsBond struct {
+	Members []string
+	Options link.BondOptions
+}
+
+func (o *OvsNode) isBondMember(ifName string) bool {
+	for _, bond := range o.Bonds {
+		if slices.Contains(bond.Members, ifName) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *OvsNode) GetName() string {
 	return s.Name
 }
@@ -50,6 +67,18 @@ func (o *OvsNode) IsRunning() bool {
 	return o.Running
 }
 
+func (o *OvsNode) IsPaused() bool {
+	return false
+}
+
+func (o *OvsNode) Pause() error {
+	return fmt.Errorf("pause is not supported on ovswitch")
+}
+
+func (o *OvsNode) Resume() error {
+	return fmt.Errorf("resume is not supported on ovswitch")
+}
+
 func (o *OvsNode) ExecCommand(
 	cmd []string,
 	in io.ReadCloser,
@@ -113,6 +142,17 @@ func (o *OvsNode) Capture(ifIndex int, out io.Writer) error {
 	return o.OvsInstance.Capture(o.GetInterfaceName(ifIndex), out)
 }
 
+// WaitReady does nothing, the ovs instance is ready when started
+func (o *OvsNode) WaitReady(timeout int) error {
+	return nil
+}
+
+// Logs returns the logs of the ovs container shared by all
+// the switches of the project
+func (o *OvsNode) Logs(ctx context.Context, opts docker.LogsOptions, stdout, stderr io.Writer) error {
+	return o.OvsInstance.Logs(ctx, opts, stdout, stderr)
+}
+
 func (o *OvsNode) Start() error {
 	if !o.Running {
 		if err := o.OvsInstance.AddBr(o.GetBridgeName()); err != nil {
@@ -121,10 +161,18 @@ func (o *OvsNode) Start() error {
 		o.Running = true
 
 		for ifName := range o.Interfaces {
+			if o.isBondMember(ifName) {
+				continue
+			}
 			if err := o.OvsInstance.AddPort(o.GetBridgeName(), ifName); err != nil {
 				return err
 			}
 		}
+		for name, bond := range o.Bonds {
+			if err := o.OvsInstance.AddBond(o.GetBridgeName(), name, bond.Members, bond.Options); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -133,10 +181,18 @@ func (o *OvsNode) Start() error {
 func (o *OvsNode) Stop() error {
 	if o.Running {
 		for ifName := range o.Interfaces {
+			if o.isBondMember(ifName) {
+				continue
+			}
 			if err := o.OvsInstance.DelPort(o.GetBridgeName(), ifName); err != nil {
 				return err
 			}
 		}
+		for name := range o.Bonds {
+			if err := o.OvsInstance.DelPort(o.GetBridgeName(), name); err != nil {
+				return err
+			}
+		}
 
 		if err := o.OvsInstance.DelBr(o.GetBridgeName()); err != nil {
 			return err
@@ -148,7 +204,8 @@ func (o *OvsNode) Stop() error {
 }
 
 func (o *OvsNode) AttachInterface(ifName string, ifIndex int, configure bool) error {
-	if o.Running {
+	// members of a bond are bound again to it by name
+	if o.Running && !o.isBondMember(ifName) {
 		if err := o.OvsInstance.AddPort(o.GetBridgeName(), ifName); err != nil {
 			return err
 		}
@@ -159,6 +216,39 @@ func (o *OvsNode) AttachInterface(ifName string, ifIndex int, configure bool) er
 	return nil
 }
 
+// DetachInterface removes the port of an interface deleted outside of
+// the switch
+func (o *OvsNode) DetachInterface(ifName string) error {
+	if _, found := o.Interfaces[ifName]; !found {
+		return nil
+	}
+
+	if o.Running && !o.isBondMember(ifName) {
+		if err := o.OvsInstance.DelPort(o.GetBridgeName(), ifName); err != nil {
+			return err
+		}
+	}
+	delete(o.Interfaces, ifName)
+
+	return nil
+}
+
+// SetupBond aggregates the interfaces in a bond port named <switch>.<name>
+func (o *OvsNode) SetupBond(name string, ifIndexes []int, opts link.BondOptions) error {
+	bondName := fmt.Sprintf("%s.%s", o.GetBridgeName(), name)
+
+	members := make([]string, len(ifIndexes))
+	for idx, ifIndex := range ifIndexes {
+		members[idx] = o.GetInterfaceName(ifIndex)
+	}
+	o.Bonds[bondName] = OvsBond{Members: members, Options: opts}
+
+	if o.Running {
+		return o.OvsInstance.AddBond(o.GetBridgeName(), bondName, members, opts)
+	}
+	return nil
+}
+
 func (n *OvsNode) ConfigureInterfaces() error {
 	return nil
 }
@@ -266,6 +356,7 @@ func NewOvsNode(prjID, name, shortName string) (*OvsNode, error) {
 		Name:       name,
 		ShortName:  shortName,
 		Interfaces: make(map[string]link.IfState),
+		Bonds:      make(map[string]OvsBond),
 		Logger: logrus.WithFields(logrus.Fields{
 			"project": prjID,
 			"node":    "ovs-" + name,
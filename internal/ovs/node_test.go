@@ -95,6 +95,7 @@ func TestOvsNode_AttachLink(t *testing.T) {
 	_, err = link.CreateVethLink(
 		node1.GetInterfaceName(0), node1Netns,
 		node2.GetInterfaceName(0), node2Netns,
+		link.VethOptions{},
 	)
 	if err != nil {
 		t.Fatalf("Unable to create veth: %v", err)
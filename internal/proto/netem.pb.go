@@ -220,6 +220,107 @@ func (ExecSrvMsg_Code) EnumDescriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type DialCltMsg_Code int32
+
+const (
+	DialCltMsg_CONNECT DialCltMsg_Code = 0
+	DialCltMsg_DATA    DialCltMsg_Code = 1
+	DialCltMsg_CLOSE   DialCltMsg_Code = 2
+)
+
+// Enum value maps for DialCltMsg_Code.
+var (
+	DialCltMsg_Code_name = map[int32]string{
+		0: "CONNECT",
+		1: "DATA",
+		2: "CLOSE",
+	}
+	DialCltMsg_Code_value = map[string]int32{
+		"CONNECT": 0,
+		"DATA":    1,
+		"CLOSE":   2,
+	}
+)
+
+func (x DialCltMsg_Code) Enum() *DialCltMsg_Code {
+	p := new(DialCltMsg_Code)
+	*p = x
+	return p
+}
+
+func (x DialCltMsg_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DialCltMsg_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_netem_proto_enumTypes[4].Descriptor()
+}
+
+func (DialCltMsg_Code) Type() protoreflect.EnumType {
+	return &file_internal_proto_netem_proto_enumTypes[4]
+}
+
+func (x DialCltMsg_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DialCltMsg_Code.Descriptor instead.
+func (DialCltMsg_Code) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type DialSrvMsg_Code int32
+
+const (
+	DialSrvMsg_CONNECTED DialSrvMsg_Code = 0
+	DialSrvMsg_DATA      DialSrvMsg_Code = 1
+	DialSrvMsg_ERROR     DialSrvMsg_Code = 2
+	DialSrvMsg_CLOSE     DialSrvMsg_Code = 3
+)
+
+// Enum value maps for DialSrvMsg_Code.
+var (
+	DialSrvMsg_Code_name = map[int32]string{
+		0: "CONNECTED",
+		1: "DATA",
+		2: "ERROR",
+		3: "CLOSE",
+	}
+	DialSrvMsg_Code_value = map[string]int32{
+		"CONNECTED": 0,
+		"DATA":      1,
+		"ERROR":     2,
+		"CLOSE":     3,
+	}
+)
+
+func (x DialSrvMsg_Code) Enum() *DialSrvMsg_Code {
+	p := new(DialSrvMsg_Code)
+	*p = x
+	return p
+}
+
+func (x DialSrvMsg_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DialSrvMsg_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_netem_proto_enumTypes[5].Descriptor()
+}
+
+func (DialSrvMsg_Code) Type() protoreflect.EnumType {
+	return &file_internal_proto_netem_proto_enumTypes[5]
+}
+
+func (x DialSrvMsg_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DialSrvMsg_Code.Descriptor instead.
+func (DialSrvMsg_Code) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type CopyMsg_Code int32
 
 const (
@@ -253,11 +354,11 @@ func (x CopyMsg_Code) String() string {
 }
 
 func (CopyMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[4].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[6].Descriptor()
 }
 
 func (CopyMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[4]
+	return &file_internal_proto_netem_proto_enumTypes[6]
 }
 
 func (x CopyMsg_Code) Number() protoreflect.EnumNumber {
@@ -266,7 +367,7 @@ func (x CopyMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CopyMsg_Code.Descriptor instead.
 func (CopyMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{2, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{4, 0}
 }
 
 type PullSrvMsg_Code int32
@@ -302,11 +403,11 @@ func (x PullSrvMsg_Code) String() string {
 }
 
 func (PullSrvMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[5].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[7].Descriptor()
 }
 
 func (PullSrvMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[5]
+	return &file_internal_proto_netem_proto_enumTypes[7]
 }
 
 func (x PullSrvMsg_Code) Number() protoreflect.EnumNumber {
@@ -315,7 +416,7 @@ func (x PullSrvMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PullSrvMsg_Code.Descriptor instead.
 func (PullSrvMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{3, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type CaptureSrvMsg_Code int32
@@ -354,11 +455,11 @@ func (x CaptureSrvMsg_Code) String() string {
 }
 
 func (CaptureSrvMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[6].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[8].Descriptor()
 }
 
 func (CaptureSrvMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[6]
+	return &file_internal_proto_netem_proto_enumTypes[8]
 }
 
 func (x CaptureSrvMsg_Code) Number() protoreflect.EnumNumber {
@@ -367,7 +468,105 @@ func (x CaptureSrvMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CaptureSrvMsg_Code.Descriptor instead.
 func (CaptureSrvMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{4, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type LogsSrvMsg_Code int32
+
+const (
+	LogsSrvMsg_STDOUT LogsSrvMsg_Code = 0
+	LogsSrvMsg_STDERR LogsSrvMsg_Code = 1
+	LogsSrvMsg_ERROR  LogsSrvMsg_Code = 2
+)
+
+// Enum value maps for LogsSrvMsg_Code.
+var (
+	LogsSrvMsg_Code_name = map[int32]string{
+		0: "STDOUT",
+		1: "STDERR",
+		2: "ERROR",
+	}
+	LogsSrvMsg_Code_value = map[string]int32{
+		"STDOUT": 0,
+		"STDERR": 1,
+		"ERROR":  2,
+	}
+)
+
+func (x LogsSrvMsg_Code) Enum() *LogsSrvMsg_Code {
+	p := new(LogsSrvMsg_Code)
+	*p = x
+	return p
+}
+
+func (x LogsSrvMsg_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogsSrvMsg_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_netem_proto_enumTypes[9].Descriptor()
+}
+
+func (LogsSrvMsg_Code) Type() protoreflect.EnumType {
+	return &file_internal_proto_netem_proto_enumTypes[9]
+}
+
+func (x LogsSrvMsg_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogsSrvMsg_Code.Descriptor instead.
+func (LogsSrvMsg_Code) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type NodeEventMsg_Code int32
+
+const (
+	NodeEventMsg_DIED           NodeEventMsg_Code = 0
+	NodeEventMsg_RESTARTED      NodeEventMsg_Code = 1
+	NodeEventMsg_RESTART_FAILED NodeEventMsg_Code = 2
+)
+
+// Enum value maps for NodeEventMsg_Code.
+var (
+	NodeEventMsg_Code_name = map[int32]string{
+		0: "DIED",
+		1: "RESTARTED",
+		2: "RESTART_FAILED",
+	}
+	NodeEventMsg_Code_value = map[string]int32{
+		"DIED":           0,
+		"RESTARTED":      1,
+		"RESTART_FAILED": 2,
+	}
+)
+
+func (x NodeEventMsg_Code) Enum() *NodeEventMsg_Code {
+	p := new(NodeEventMsg_Code)
+	*p = x
+	return p
+}
+
+func (x NodeEventMsg_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeEventMsg_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_netem_proto_enumTypes[10].Descriptor()
+}
+
+func (NodeEventMsg_Code) Type() protoreflect.EnumType {
+	return &file_internal_proto_netem_proto_enumTypes[10]
+}
+
+func (x NodeEventMsg_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeEventMsg_Code.Descriptor instead.
+func (NodeEventMsg_Code) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{8, 0}
 }
 
 type TopologyRunMsg_Code int32
@@ -424,11 +623,11 @@ func (x TopologyRunMsg_Code) String() string {
 }
 
 func (TopologyRunMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[7].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[11].Descriptor()
 }
 
 func (TopologyRunMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[7]
+	return &file_internal_proto_netem_proto_enumTypes[11]
 }
 
 func (x TopologyRunMsg_Code) Number() protoreflect.EnumNumber {
@@ -437,7 +636,7 @@ func (x TopologyRunMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TopologyRunMsg_Code.Descriptor instead.
 func (TopologyRunMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{5, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type ProjectSaveMsg_Code int32
@@ -473,11 +672,11 @@ func (x ProjectSaveMsg_Code) String() string {
 }
 
 func (ProjectSaveMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[8].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[12].Descriptor()
 }
 
 func (ProjectSaveMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[8]
+	return &file_internal_proto_netem_proto_enumTypes[12]
 }
 
 func (x ProjectSaveMsg_Code) Number() protoreflect.EnumNumber {
@@ -486,7 +685,7 @@ func (x ProjectSaveMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProjectSaveMsg_Code.Descriptor instead.
 func (ProjectSaveMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{6, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type ProjectCloseMsg_Code int32
@@ -525,11 +724,11 @@ func (x ProjectCloseMsg_Code) String() string {
 }
 
 func (ProjectCloseMsg_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[9].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[13].Descriptor()
 }
 
 func (ProjectCloseMsg_Code) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[9]
+	return &file_internal_proto_netem_proto_enumTypes[13]
 }
 
 func (x ProjectCloseMsg_Code) Number() protoreflect.EnumNumber {
@@ -538,7 +737,56 @@ func (x ProjectCloseMsg_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProjectCloseMsg_Code.Descriptor instead.
 func (ProjectCloseMsg_Code) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{7, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type TopologyExportRequest_Format int32
+
+const (
+	TopologyExportRequest_DOT  TopologyExportRequest_Format = 0
+	TopologyExportRequest_SVG  TopologyExportRequest_Format = 1
+	TopologyExportRequest_JSON TopologyExportRequest_Format = 2
+)
+
+// Enum value maps for TopologyExportRequest_Format.
+var (
+	TopologyExportRequest_Format_name = map[int32]string{
+		0: "DOT",
+		1: "SVG",
+		2: "JSON",
+	}
+	TopologyExportRequest_Format_value = map[string]int32{
+		"DOT":  0,
+		"SVG":  1,
+		"JSON": 2,
+	}
+)
+
+func (x TopologyExportRequest_Format) Enum() *TopologyExportRequest_Format {
+	p := new(TopologyExportRequest_Format)
+	*p = x
+	return p
+}
+
+func (x TopologyExportRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopologyExportRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_netem_proto_enumTypes[14].Descriptor()
+}
+
+func (TopologyExportRequest_Format) Type() protoreflect.EnumType {
+	return &file_internal_proto_netem_proto_enumTypes[14]
+}
+
+func (x TopologyExportRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopologyExportRequest_Format.Descriptor instead.
+func (TopologyExportRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type ConfigFilesResponse_Source int32
@@ -571,11 +819,11 @@ func (x ConfigFilesResponse_Source) String() string {
 }
 
 func (ConfigFilesResponse_Source) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_netem_proto_enumTypes[10].Descriptor()
+	return file_internal_proto_netem_proto_enumTypes[15].Descriptor()
 }
 
 func (ConfigFilesResponse_Source) Type() protoreflect.EnumType {
-	return &file_internal_proto_netem_proto_enumTypes[10]
+	return &file_internal_proto_netem_proto_enumTypes[15]
 }
 
 func (x ConfigFilesResponse_Source) Number() protoreflect.EnumNumber {
@@ -584,7 +832,7 @@ func (x ConfigFilesResponse_Source) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConfigFilesResponse_Source.Descriptor instead.
 func (ConfigFilesResponse_Source) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{23, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type ExecCltMsg struct {
@@ -745,20 +993,22 @@ func (x *ExecSrvMsg) GetData() []byte {
 	return nil
 }
 
-type CopyMsg struct {
+type DialCltMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code     CopyMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.CopyMsg_Code" json:"code,omitempty"`
-	PrjId    string       `protobuf:"bytes,2,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Node     string       `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
-	NodePath string       `protobuf:"bytes,4,opt,name=nodePath,proto3" json:"nodePath,omitempty"`
-	Data     []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Code  DialCltMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.DialCltMsg_Code" json:"code,omitempty"`
+	PrjId string          `protobuf:"bytes,2,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node  string          `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// address in the netns of the node, 127.0.0.1 if empty
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Port    int32  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Data    []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CopyMsg) Reset() {
-	*x = CopyMsg{}
+func (x *DialCltMsg) Reset() {
+	*x = DialCltMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,13 +1016,13 @@ func (x *CopyMsg) Reset() {
 	}
 }
 
-func (x *CopyMsg) String() string {
+func (x *DialCltMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyMsg) ProtoMessage() {}
+func (*DialCltMsg) ProtoMessage() {}
 
-func (x *CopyMsg) ProtoReflect() protoreflect.Message {
+func (x *DialCltMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,58 +1034,64 @@ func (x *CopyMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyMsg.ProtoReflect.Descriptor instead.
-func (*CopyMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DialCltMsg.ProtoReflect.Descriptor instead.
+func (*DialCltMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CopyMsg) GetCode() CopyMsg_Code {
+func (x *DialCltMsg) GetCode() DialCltMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return CopyMsg_INIT
+	return DialCltMsg_CONNECT
 }
 
-func (x *CopyMsg) GetPrjId() string {
+func (x *DialCltMsg) GetPrjId() string {
 	if x != nil {
 		return x.PrjId
 	}
 	return ""
 }
 
-func (x *CopyMsg) GetNode() string {
+func (x *DialCltMsg) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CopyMsg) GetNodePath() string {
+func (x *DialCltMsg) GetAddress() string {
 	if x != nil {
-		return x.NodePath
+		return x.Address
 	}
 	return ""
 }
 
-func (x *CopyMsg) GetData() []byte {
+func (x *DialCltMsg) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DialCltMsg) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type PullSrvMsg struct {
+type DialSrvMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  PullSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.PullSrvMsg_Code" json:"code,omitempty"`
-	Image string          `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Error string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code DialSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.DialSrvMsg_Code" json:"code,omitempty"`
+	Data []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *PullSrvMsg) Reset() {
-	*x = PullSrvMsg{}
+func (x *DialSrvMsg) Reset() {
+	*x = DialSrvMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -843,13 +1099,13 @@ func (x *PullSrvMsg) Reset() {
 	}
 }
 
-func (x *PullSrvMsg) String() string {
+func (x *DialSrvMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullSrvMsg) ProtoMessage() {}
+func (*DialSrvMsg) ProtoMessage() {}
 
-func (x *PullSrvMsg) ProtoReflect() protoreflect.Message {
+func (x *DialSrvMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,43 +1117,39 @@ func (x *PullSrvMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullSrvMsg.ProtoReflect.Descriptor instead.
-func (*PullSrvMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DialSrvMsg.ProtoReflect.Descriptor instead.
+func (*DialSrvMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PullSrvMsg) GetCode() PullSrvMsg_Code {
+func (x *DialSrvMsg) GetCode() DialSrvMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return PullSrvMsg_START
-}
-
-func (x *PullSrvMsg) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+	return DialSrvMsg_CONNECTED
 }
 
-func (x *PullSrvMsg) GetError() string {
+func (x *DialSrvMsg) GetData() []byte {
 	if x != nil {
-		return x.Error
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type CaptureSrvMsg struct {
+type CopyMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code CaptureSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.CaptureSrvMsg_Code" json:"code,omitempty"`
-	Data []byte             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Code     CopyMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.CopyMsg_Code" json:"code,omitempty"`
+	PrjId    string       `protobuf:"bytes,2,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node     string       `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	NodePath string       `protobuf:"bytes,4,opt,name=nodePath,proto3" json:"nodePath,omitempty"`
+	Data     []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CaptureSrvMsg) Reset() {
-	*x = CaptureSrvMsg{}
+func (x *CopyMsg) Reset() {
+	*x = CopyMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,13 +1157,13 @@ func (x *CaptureSrvMsg) Reset() {
 	}
 }
 
-func (x *CaptureSrvMsg) String() string {
+func (x *CopyMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptureSrvMsg) ProtoMessage() {}
+func (*CopyMsg) ProtoMessage() {}
 
-func (x *CaptureSrvMsg) ProtoReflect() protoreflect.Message {
+func (x *CopyMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,37 +1175,58 @@ func (x *CaptureSrvMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptureSrvMsg.ProtoReflect.Descriptor instead.
-func (*CaptureSrvMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use CopyMsg.ProtoReflect.Descriptor instead.
+func (*CopyMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CaptureSrvMsg) GetCode() CaptureSrvMsg_Code {
+func (x *CopyMsg) GetCode() CopyMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return CaptureSrvMsg_STDOUT
+	return CopyMsg_INIT
 }
 
-func (x *CaptureSrvMsg) GetData() []byte {
+func (x *CopyMsg) GetPrjId() string {
+	if x != nil {
+		return x.PrjId
+	}
+	return ""
+}
+
+func (x *CopyMsg) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CopyMsg) GetNodePath() string {
+	if x != nil {
+		return x.NodePath
+	}
+	return ""
+}
+
+func (x *CopyMsg) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type TopologyRunMsg struct {
+type PullSrvMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code         TopologyRunMsg_Code            `protobuf:"varint,1,opt,name=code,proto3,enum=netem.TopologyRunMsg_Code" json:"code,omitempty"`
-	Total        int32                          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	NodeMessages []*TopologyRunMsg_NodeMessages `protobuf:"bytes,3,rep,name=nodeMessages,proto3" json:"nodeMessages,omitempty"`
+	Code  PullSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.PullSrvMsg_Code" json:"code,omitempty"`
+	Image string          `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Error string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TopologyRunMsg) Reset() {
-	*x = TopologyRunMsg{}
+func (x *PullSrvMsg) Reset() {
+	*x = PullSrvMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,13 +1234,13 @@ func (x *TopologyRunMsg) Reset() {
 	}
 }
 
-func (x *TopologyRunMsg) String() string {
+func (x *PullSrvMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopologyRunMsg) ProtoMessage() {}
+func (*PullSrvMsg) ProtoMessage() {}
 
-func (x *TopologyRunMsg) ProtoReflect() protoreflect.Message {
+func (x *PullSrvMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -979,44 +1252,43 @@ func (x *TopologyRunMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopologyRunMsg.ProtoReflect.Descriptor instead.
-func (*TopologyRunMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullSrvMsg.ProtoReflect.Descriptor instead.
+func (*PullSrvMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TopologyRunMsg) GetCode() TopologyRunMsg_Code {
+func (x *PullSrvMsg) GetCode() PullSrvMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return TopologyRunMsg_NODE_COUNT
+	return PullSrvMsg_START
 }
 
-func (x *TopologyRunMsg) GetTotal() int32 {
+func (x *PullSrvMsg) GetImage() string {
 	if x != nil {
-		return x.Total
+		return x.Image
 	}
-	return 0
+	return ""
 }
 
-func (x *TopologyRunMsg) GetNodeMessages() []*TopologyRunMsg_NodeMessages {
+func (x *PullSrvMsg) GetError() string {
 	if x != nil {
-		return x.NodeMessages
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type ProjectSaveMsg struct {
+type CaptureSrvMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  ProjectSaveMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.ProjectSaveMsg_Code" json:"code,omitempty"`
-	Data  []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Total int32               `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Code CaptureSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.CaptureSrvMsg_Code" json:"code,omitempty"`
+	Data []byte             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ProjectSaveMsg) Reset() {
-	*x = ProjectSaveMsg{}
+func (x *CaptureSrvMsg) Reset() {
+	*x = CaptureSrvMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,13 +1296,13 @@ func (x *ProjectSaveMsg) Reset() {
 	}
 }
 
-func (x *ProjectSaveMsg) String() string {
+func (x *CaptureSrvMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectSaveMsg) ProtoMessage() {}
+func (*CaptureSrvMsg) ProtoMessage() {}
 
-func (x *ProjectSaveMsg) ProtoReflect() protoreflect.Message {
+func (x *CaptureSrvMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,43 +1314,36 @@ func (x *ProjectSaveMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectSaveMsg.ProtoReflect.Descriptor instead.
-func (*ProjectSaveMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use CaptureSrvMsg.ProtoReflect.Descriptor instead.
+func (*CaptureSrvMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ProjectSaveMsg) GetCode() ProjectSaveMsg_Code {
+func (x *CaptureSrvMsg) GetCode() CaptureSrvMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return ProjectSaveMsg_NODE_COUNT
+	return CaptureSrvMsg_STDOUT
 }
 
-func (x *ProjectSaveMsg) GetData() []byte {
+func (x *CaptureSrvMsg) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ProjectSaveMsg) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type ProjectCloseMsg struct {
+type LogsSrvMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  ProjectCloseMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.ProjectCloseMsg_Code" json:"code,omitempty"`
-	Total int32                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code LogsSrvMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.LogsSrvMsg_Code" json:"code,omitempty"`
+	Data []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ProjectCloseMsg) Reset() {
-	*x = ProjectCloseMsg{}
+func (x *LogsSrvMsg) Reset() {
+	*x = LogsSrvMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,13 +1351,13 @@ func (x *ProjectCloseMsg) Reset() {
 	}
 }
 
-func (x *ProjectCloseMsg) String() string {
+func (x *LogsSrvMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectCloseMsg) ProtoMessage() {}
+func (*LogsSrvMsg) ProtoMessage() {}
 
-func (x *ProjectCloseMsg) ProtoReflect() protoreflect.Message {
+func (x *LogsSrvMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1104,38 +1369,37 @@ func (x *ProjectCloseMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectCloseMsg.ProtoReflect.Descriptor instead.
-func (*ProjectCloseMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogsSrvMsg.ProtoReflect.Descriptor instead.
+func (*LogsSrvMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ProjectCloseMsg) GetCode() ProjectCloseMsg_Code {
+func (x *LogsSrvMsg) GetCode() LogsSrvMsg_Code {
 	if x != nil {
 		return x.Code
 	}
-	return ProjectCloseMsg_NODE_COUNT
+	return LogsSrvMsg_STDOUT
 }
 
-func (x *ProjectCloseMsg) GetTotal() int32 {
+func (x *LogsSrvMsg) GetData() []byte {
 	if x != nil {
-		return x.Total
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type LinkConfig struct {
+type NodeEventMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peer1    string                `protobuf:"bytes,1,opt,name=peer1,proto3" json:"peer1,omitempty"`
-	Peer2    string                `protobuf:"bytes,2,opt,name=peer2,proto3" json:"peer2,omitempty"`
-	Peer1Qos *LinkConfig_QoSConfig `protobuf:"bytes,3,opt,name=peer1qos,proto3" json:"peer1qos,omitempty"`
-	Peer2Qos *LinkConfig_QoSConfig `protobuf:"bytes,4,opt,name=peer2qos,proto3" json:"peer2qos,omitempty"`
+	Code    NodeEventMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.NodeEventMsg_Code" json:"code,omitempty"`
+	Node    string            `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Message string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *LinkConfig) Reset() {
-	*x = LinkConfig{}
+func (x *NodeEventMsg) Reset() {
+	*x = NodeEventMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1143,13 +1407,13 @@ func (x *LinkConfig) Reset() {
 	}
 }
 
-func (x *LinkConfig) String() string {
+func (x *NodeEventMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkConfig) ProtoMessage() {}
+func (*NodeEventMsg) ProtoMessage() {}
 
-func (x *LinkConfig) ProtoReflect() protoreflect.Message {
+func (x *NodeEventMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,51 +1425,44 @@ func (x *LinkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkConfig.ProtoReflect.Descriptor instead.
-func (*LinkConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeEventMsg.ProtoReflect.Descriptor instead.
+func (*NodeEventMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LinkConfig) GetPeer1() string {
+func (x *NodeEventMsg) GetCode() NodeEventMsg_Code {
 	if x != nil {
-		return x.Peer1
+		return x.Code
 	}
-	return ""
+	return NodeEventMsg_DIED
 }
 
-func (x *LinkConfig) GetPeer2() string {
+func (x *NodeEventMsg) GetNode() string {
 	if x != nil {
-		return x.Peer2
+		return x.Node
 	}
 	return ""
 }
 
-func (x *LinkConfig) GetPeer1Qos() *LinkConfig_QoSConfig {
-	if x != nil {
-		return x.Peer1Qos
-	}
-	return nil
-}
-
-func (x *LinkConfig) GetPeer2Qos() *LinkConfig_QoSConfig {
+func (x *NodeEventMsg) GetMessage() string {
 	if x != nil {
-		return x.Peer2Qos
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type LinkRequest struct {
+type TopologyRunMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrjId string      `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Link  *LinkConfig `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
-	Sync  bool        `protobuf:"varint,3,opt,name=sync,proto3" json:"sync,omitempty"`
+	Code         TopologyRunMsg_Code            `protobuf:"varint,1,opt,name=code,proto3,enum=netem.TopologyRunMsg_Code" json:"code,omitempty"`
+	Total        int32                          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NodeMessages []*TopologyRunMsg_NodeMessages `protobuf:"bytes,3,rep,name=nodeMessages,proto3" json:"nodeMessages,omitempty"`
 }
 
-func (x *LinkRequest) Reset() {
-	*x = LinkRequest{}
+func (x *TopologyRunMsg) Reset() {
+	*x = TopologyRunMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,13 +1470,13 @@ func (x *LinkRequest) Reset() {
 	}
 }
 
-func (x *LinkRequest) String() string {
+func (x *TopologyRunMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkRequest) ProtoMessage() {}
+func (*TopologyRunMsg) ProtoMessage() {}
 
-func (x *LinkRequest) ProtoReflect() protoreflect.Message {
+func (x *TopologyRunMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,45 +1488,44 @@ func (x *LinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
-func (*LinkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopologyRunMsg.ProtoReflect.Descriptor instead.
+func (*TopologyRunMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LinkRequest) GetPrjId() string {
+func (x *TopologyRunMsg) GetCode() TopologyRunMsg_Code {
 	if x != nil {
-		return x.PrjId
+		return x.Code
 	}
-	return ""
+	return TopologyRunMsg_NODE_COUNT
 }
 
-func (x *LinkRequest) GetLink() *LinkConfig {
+func (x *TopologyRunMsg) GetTotal() int32 {
 	if x != nil {
-		return x.Link
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkRequest) GetSync() bool {
+func (x *TopologyRunMsg) GetNodeMessages() []*TopologyRunMsg_NodeMessages {
 	if x != nil {
-		return x.Sync
+		return x.NodeMessages
 	}
-	return false
+	return nil
 }
 
-type NodeIfStateRequest struct {
+type ProjectSaveMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrjId   string  `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Node    string  `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	IfIndex int32   `protobuf:"varint,3,opt,name=ifIndex,proto3" json:"ifIndex,omitempty"`
-	State   IfState `protobuf:"varint,4,opt,name=state,proto3,enum=netem.IfState" json:"state,omitempty"`
+	Code  ProjectSaveMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.ProjectSaveMsg_Code" json:"code,omitempty"`
+	Data  []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Total int32               `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *NodeIfStateRequest) Reset() {
-	*x = NodeIfStateRequest{}
+func (x *ProjectSaveMsg) Reset() {
+	*x = ProjectSaveMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1277,13 +1533,13 @@ func (x *NodeIfStateRequest) Reset() {
 	}
 }
 
-func (x *NodeIfStateRequest) String() string {
+func (x *ProjectSaveMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeIfStateRequest) ProtoMessage() {}
+func (*ProjectSaveMsg) ProtoMessage() {}
 
-func (x *NodeIfStateRequest) ProtoReflect() protoreflect.Message {
+func (x *ProjectSaveMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,51 +1551,43 @@ func (x *NodeIfStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeIfStateRequest.ProtoReflect.Descriptor instead.
-func (*NodeIfStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectSaveMsg.ProtoReflect.Descriptor instead.
+func (*ProjectSaveMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *NodeIfStateRequest) GetPrjId() string {
+func (x *ProjectSaveMsg) GetCode() ProjectSaveMsg_Code {
 	if x != nil {
-		return x.PrjId
+		return x.Code
 	}
-	return ""
+	return ProjectSaveMsg_NODE_COUNT
 }
 
-func (x *NodeIfStateRequest) GetNode() string {
+func (x *ProjectSaveMsg) GetData() []byte {
 	if x != nil {
-		return x.Node
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeIfStateRequest) GetIfIndex() int32 {
+func (x *ProjectSaveMsg) GetTotal() int32 {
 	if x != nil {
-		return x.IfIndex
+		return x.Total
 	}
 	return 0
 }
 
-func (x *NodeIfStateRequest) GetState() IfState {
-	if x != nil {
-		return x.State
-	}
-	return IfState_UP
-}
-
-type NodeInterfaceRequest struct {
+type ProjectCloseMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrjId   string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Node    string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	IfIndex int32  `protobuf:"varint,3,opt,name=ifIndex,proto3" json:"ifIndex,omitempty"`
+	Code  ProjectCloseMsg_Code `protobuf:"varint,1,opt,name=code,proto3,enum=netem.ProjectCloseMsg_Code" json:"code,omitempty"`
+	Total int32                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *NodeInterfaceRequest) Reset() {
-	*x = NodeInterfaceRequest{}
+func (x *ProjectCloseMsg) Reset() {
+	*x = ProjectCloseMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1347,13 +1595,13 @@ func (x *NodeInterfaceRequest) Reset() {
 	}
 }
 
-func (x *NodeInterfaceRequest) String() string {
+func (x *ProjectCloseMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeInterfaceRequest) ProtoMessage() {}
+func (*ProjectCloseMsg) ProtoMessage() {}
 
-func (x *NodeInterfaceRequest) ProtoReflect() protoreflect.Message {
+func (x *ProjectCloseMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1365,43 +1613,40 @@ func (x *NodeInterfaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeInterfaceRequest.ProtoReflect.Descriptor instead.
-func (*NodeInterfaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectCloseMsg.ProtoReflect.Descriptor instead.
+func (*ProjectCloseMsg) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NodeInterfaceRequest) GetPrjId() string {
+func (x *ProjectCloseMsg) GetCode() ProjectCloseMsg_Code {
 	if x != nil {
-		return x.PrjId
+		return x.Code
 	}
-	return ""
+	return ProjectCloseMsg_NODE_COUNT
 }
 
-func (x *NodeInterfaceRequest) GetNode() string {
+func (x *ProjectCloseMsg) GetTotal() int32 {
 	if x != nil {
-		return x.Node
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeInterfaceRequest) GetIfIndex() int32 {
-	if x != nil {
-		return x.IfIndex
-	}
-	return 0
-}
-
-type NodeRequest struct {
+type LinkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrjId string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Peer1       string                `protobuf:"bytes,1,opt,name=peer1,proto3" json:"peer1,omitempty"`
+	Peer2       string                `protobuf:"bytes,2,opt,name=peer2,proto3" json:"peer2,omitempty"`
+	Peer1Qos    *LinkConfig_QoSConfig `protobuf:"bytes,3,opt,name=peer1qos,proto3" json:"peer1qos,omitempty"`
+	Peer2Qos    *LinkConfig_QoSConfig `protobuf:"bytes,4,opt,name=peer2qos,proto3" json:"peer2qos,omitempty"`
+	Profile     string                `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	Description string                `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *NodeRequest) Reset() {
-	*x = NodeRequest{}
+func (x *LinkConfig) Reset() {
+	*x = LinkConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1409,13 +1654,13 @@ func (x *NodeRequest) Reset() {
 	}
 }
 
-func (x *NodeRequest) String() string {
+func (x *LinkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRequest) ProtoMessage() {}
+func (*LinkConfig) ProtoMessage() {}
 
-func (x *NodeRequest) ProtoReflect() protoreflect.Message {
+func (x *LinkConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1427,37 +1672,65 @@ func (x *NodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRequest.ProtoReflect.Descriptor instead.
-func (*NodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkConfig.ProtoReflect.Descriptor instead.
+func (*LinkConfig) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NodeRequest) GetPrjId() string {
+func (x *LinkConfig) GetPeer1() string {
 	if x != nil {
-		return x.PrjId
+		return x.Peer1
 	}
 	return ""
 }
 
-func (x *NodeRequest) GetNode() string {
+func (x *LinkConfig) GetPeer2() string {
 	if x != nil {
-		return x.Node
+		return x.Peer2
 	}
 	return ""
 }
 
-type ConsoleCmdRequest struct {
+func (x *LinkConfig) GetPeer1Qos() *LinkConfig_QoSConfig {
+	if x != nil {
+		return x.Peer1Qos
+	}
+	return nil
+}
+
+func (x *LinkConfig) GetPeer2Qos() *LinkConfig_QoSConfig {
+	if x != nil {
+		return x.Peer2Qos
+	}
+	return nil
+}
+
+func (x *LinkConfig) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *LinkConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type LinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrjId string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
-	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Shell bool   `protobuf:"varint,3,opt,name=shell,proto3" json:"shell,omitempty"`
+	PrjId string      `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Link  *LinkConfig `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Sync  bool        `protobuf:"varint,3,opt,name=sync,proto3" json:"sync,omitempty"`
 }
 
-func (x *ConsoleCmdRequest) Reset() {
-	*x = ConsoleCmdRequest{}
+func (x *LinkRequest) Reset() {
+	*x = LinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1465,13 +1738,13 @@ func (x *ConsoleCmdRequest) Reset() {
 	}
 }
 
-func (x *ConsoleCmdRequest) String() string {
+func (x *LinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsoleCmdRequest) ProtoMessage() {}
+func (*LinkRequest) ProtoMessage() {}
 
-func (x *ConsoleCmdRequest) ProtoReflect() protoreflect.Message {
+func (x *LinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1483,42 +1756,49 @@ func (x *ConsoleCmdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsoleCmdRequest.ProtoReflect.Descriptor instead.
-func (*ConsoleCmdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
+func (*LinkRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ConsoleCmdRequest) GetPrjId() string {
+func (x *LinkRequest) GetPrjId() string {
 	if x != nil {
 		return x.PrjId
 	}
 	return ""
 }
 
-func (x *ConsoleCmdRequest) GetNode() string {
+func (x *LinkRequest) GetLink() *LinkConfig {
 	if x != nil {
-		return x.Node
+		return x.Link
 	}
-	return ""
+	return nil
 }
 
-func (x *ConsoleCmdRequest) GetShell() bool {
+func (x *LinkRequest) GetSync() bool {
 	if x != nil {
-		return x.Shell
+		return x.Sync
 	}
 	return false
 }
 
-type ProjectRequest struct {
+type RemoteLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PrjName string `protobuf:"bytes,1,opt,name=prjName,proto3" json:"prjName,omitempty"`
+	// <node>.<ifIndex> in the project
+	Peer string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	// tunnel end of the calling server
+	Vtep string `protobuf:"bytes,3,opt,name=vtep,proto3" json:"vtep,omitempty"`
+	Port int32  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Vni  uint32 `protobuf:"varint,5,opt,name=vni,proto3" json:"vni,omitempty"`
+	Mtu  int32  `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
 }
 
-func (x *ProjectRequest) Reset() {
-	*x = ProjectRequest{}
+func (x *RemoteLinkRequest) Reset() {
+	*x = RemoteLinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1526,13 +1806,13 @@ func (x *ProjectRequest) Reset() {
 	}
 }
 
-func (x *ProjectRequest) String() string {
+func (x *RemoteLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectRequest) ProtoMessage() {}
+func (*RemoteLinkRequest) ProtoMessage() {}
 
-func (x *ProjectRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoteLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1544,29 +1824,67 @@ func (x *ProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectRequest.ProtoReflect.Descriptor instead.
-func (*ProjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoteLinkRequest.ProtoReflect.Descriptor instead.
+func (*RemoteLinkRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ProjectRequest) GetId() string {
+func (x *RemoteLinkRequest) GetPrjName() string {
 	if x != nil {
-		return x.Id
+		return x.PrjName
 	}
 	return ""
 }
 
-type WNetworkRequest struct {
+func (x *RemoteLinkRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *RemoteLinkRequest) GetVtep() string {
+	if x != nil {
+		return x.Vtep
+	}
+	return ""
+}
+
+func (x *RemoteLinkRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RemoteLinkRequest) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *RemoteLinkRequest) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+type RemoteLinkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// tunnel end of the remote server
+	Vtep string `protobuf:"bytes,2,opt,name=vtep,proto3" json:"vtep,omitempty"`
+	Port int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Vni  uint32 `protobuf:"varint,4,opt,name=vni,proto3" json:"vni,omitempty"`
 }
 
-func (x *WNetworkRequest) Reset() {
-	*x = WNetworkRequest{}
+func (x *RemoteLinkResponse) Reset() {
+	*x = RemoteLinkResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1574,13 +1892,13 @@ func (x *WNetworkRequest) Reset() {
 	}
 }
 
-func (x *WNetworkRequest) String() string {
+func (x *RemoteLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WNetworkRequest) ProtoMessage() {}
+func (*RemoteLinkResponse) ProtoMessage() {}
 
-func (x *WNetworkRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoteLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1592,36 +1910,52 @@ func (x *WNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WNetworkRequest.ProtoReflect.Descriptor instead.
-func (*WNetworkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoteLinkResponse.ProtoReflect.Descriptor instead.
+func (*RemoteLinkResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WNetworkRequest) GetId() string {
+func (x *RemoteLinkResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Id
+		return x.Status
+	}
+	return nil
+}
+
+func (x *RemoteLinkResponse) GetVtep() string {
+	if x != nil {
+		return x.Vtep
 	}
 	return ""
 }
 
-func (x *WNetworkRequest) GetData() []byte {
+func (x *RemoteLinkResponse) GetPort() int32 {
 	if x != nil {
-		return x.Data
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type OpenRequest struct {
+func (x *RemoteLinkResponse) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+type NodeIfStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	PrjId   string  `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node    string  `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	IfIndex int32   `protobuf:"varint,3,opt,name=ifIndex,proto3" json:"ifIndex,omitempty"`
+	State   IfState `protobuf:"varint,4,opt,name=state,proto3,enum=netem.IfState" json:"state,omitempty"`
 }
 
-func (x *OpenRequest) Reset() {
-	*x = OpenRequest{}
+func (x *NodeIfStateRequest) Reset() {
+	*x = NodeIfStateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,13 +1963,13 @@ func (x *OpenRequest) Reset() {
 	}
 }
 
-func (x *OpenRequest) String() string {
+func (x *NodeIfStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenRequest) ProtoMessage() {}
+func (*NodeIfStateRequest) ProtoMessage() {}
 
-func (x *OpenRequest) ProtoReflect() protoreflect.Message {
+func (x *NodeIfStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1647,36 +1981,51 @@ func (x *OpenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenRequest.ProtoReflect.Descriptor instead.
-func (*OpenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeIfStateRequest.ProtoReflect.Descriptor instead.
+func (*NodeIfStateRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OpenRequest) GetName() string {
+func (x *NodeIfStateRequest) GetPrjId() string {
 	if x != nil {
-		return x.Name
+		return x.PrjId
 	}
 	return ""
 }
 
-func (x *OpenRequest) GetData() []byte {
+func (x *NodeIfStateRequest) GetNode() string {
 	if x != nil {
-		return x.Data
+		return x.Node
 	}
-	return nil
+	return ""
 }
 
-type Status struct {
+func (x *NodeIfStateRequest) GetIfIndex() int32 {
+	if x != nil {
+		return x.IfIndex
+	}
+	return 0
+}
+
+func (x *NodeIfStateRequest) GetState() IfState {
+	if x != nil {
+		return x.State
+	}
+	return IfState_UP
+}
+
+type NodeInterfaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  StatusCode `protobuf:"varint,1,opt,name=code,proto3,enum=netem.StatusCode" json:"code,omitempty"`
-	Error string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PrjId   string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node    string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	IfIndex int32  `protobuf:"varint,3,opt,name=ifIndex,proto3" json:"ifIndex,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *NodeInterfaceRequest) Reset() {
+	*x = NodeInterfaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1684,13 +2033,13 @@ func (x *Status) Reset() {
 	}
 }
 
-func (x *Status) String() string {
+func (x *NodeInterfaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*NodeInterfaceRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *NodeInterfaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1702,35 +2051,43 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeInterfaceRequest.ProtoReflect.Descriptor instead.
+func (*NodeInterfaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Status) GetCode() StatusCode {
+func (x *NodeInterfaceRequest) GetPrjId() string {
 	if x != nil {
-		return x.Code
+		return x.PrjId
 	}
-	return StatusCode_OK
+	return ""
 }
 
-func (x *Status) GetError() string {
+func (x *NodeInterfaceRequest) GetNode() string {
 	if x != nil {
-		return x.Error
+		return x.Node
 	}
 	return ""
 }
 
-type AckResponse struct {
+func (x *NodeInterfaceRequest) GetIfIndex() int32 {
+	if x != nil {
+		return x.IfIndex
+	}
+	return 0
+}
+
+type NodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PrjId string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (x *AckResponse) Reset() {
-	*x = AckResponse{}
+func (x *NodeRequest) Reset() {
+	*x = NodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1738,13 +2095,13 @@ func (x *AckResponse) Reset() {
 	}
 }
 
-func (x *AckResponse) String() string {
+func (x *NodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AckResponse) ProtoMessage() {}
+func (*NodeRequest) ProtoMessage() {}
 
-func (x *AckResponse) ProtoReflect() protoreflect.Message {
+func (x *NodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1756,29 +2113,39 @@ func (x *AckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AckResponse.ProtoReflect.Descriptor instead.
-func (*AckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeRequest.ProtoReflect.Descriptor instead.
+func (*NodeRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AckResponse) GetStatus() *Status {
+func (x *NodeRequest) GetPrjId() string {
 	if x != nil {
-		return x.Status
+		return x.PrjId
 	}
-	return nil
+	return ""
 }
 
-type FileResponse struct {
+func (x *NodeRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type NodeLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data   []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	PrjId  string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node   string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Follow bool   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	Tail   string `protobuf:"bytes,4,opt,name=tail,proto3" json:"tail,omitempty"`
+	Since  string `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
 }
 
-func (x *FileResponse) Reset() {
-	*x = FileResponse{}
+func (x *NodeLogsRequest) Reset() {
+	*x = NodeLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,13 +2153,13 @@ func (x *FileResponse) Reset() {
 	}
 }
 
-func (x *FileResponse) String() string {
+func (x *NodeLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileResponse) ProtoMessage() {}
+func (*NodeLogsRequest) ProtoMessage() {}
 
-func (x *FileResponse) ProtoReflect() protoreflect.Message {
+func (x *NodeLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,36 +2171,61 @@ func (x *FileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileResponse.ProtoReflect.Descriptor instead.
-func (*FileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeLogsRequest.ProtoReflect.Descriptor instead.
+func (*NodeLogsRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FileResponse) GetStatus() *Status {
+func (x *NodeLogsRequest) GetPrjId() string {
 	if x != nil {
-		return x.Status
+		return x.PrjId
 	}
-	return nil
+	return ""
 }
 
-func (x *FileResponse) GetData() []byte {
+func (x *NodeLogsRequest) GetNode() string {
 	if x != nil {
-		return x.Data
+		return x.Node
 	}
-	return nil
+	return ""
 }
 
-type VersionResponse struct {
+func (x *NodeLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *NodeLogsRequest) GetTail() string {
+	if x != nil {
+		return x.Tail
+	}
+	return ""
+}
+
+func (x *NodeLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+type PortForwardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Version string  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	PrjId string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// <hostPort>:<nodePort>[/tcp|udp]
+	Port   string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Delete bool   `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+	Sync   bool   `protobuf:"varint,5,opt,name=sync,proto3" json:"sync,omitempty"`
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
+func (x *PortForwardRequest) Reset() {
+	*x = PortForwardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1841,13 +2233,13 @@ func (x *VersionResponse) Reset() {
 	}
 }
 
-func (x *VersionResponse) String() string {
+func (x *PortForwardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*PortForwardRequest) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+func (x *PortForwardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1859,36 +2251,58 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortForwardRequest.ProtoReflect.Descriptor instead.
+func (*PortForwardRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *VersionResponse) GetStatus() *Status {
+func (x *PortForwardRequest) GetPrjId() string {
 	if x != nil {
-		return x.Status
+		return x.PrjId
 	}
-	return nil
+	return ""
 }
 
-func (x *VersionResponse) GetVersion() string {
+func (x *PortForwardRequest) GetNode() string {
 	if x != nil {
-		return x.Version
+		return x.Node
 	}
 	return ""
 }
 
-type ConsoleCmdResponse struct {
+func (x *PortForwardRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *PortForwardRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+func (x *PortForwardRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+type ConsoleCmdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Cmd    []string `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	PrjId string `protobuf:"bytes,1,opt,name=prjId,proto3" json:"prjId,omitempty"`
+	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Shell bool   `protobuf:"varint,3,opt,name=shell,proto3" json:"shell,omitempty"`
 }
 
-func (x *ConsoleCmdResponse) Reset() {
-	*x = ConsoleCmdResponse{}
+func (x *ConsoleCmdRequest) Reset() {
+	*x = ConsoleCmdRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1896,13 +2310,13 @@ func (x *ConsoleCmdResponse) Reset() {
 	}
 }
 
-func (x *ConsoleCmdResponse) String() string {
+func (x *ConsoleCmdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsoleCmdResponse) ProtoMessage() {}
+func (*ConsoleCmdRequest) ProtoMessage() {}
 
-func (x *ConsoleCmdResponse) ProtoReflect() protoreflect.Message {
+func (x *ConsoleCmdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1914,40 +2328,42 @@ func (x *ConsoleCmdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsoleCmdResponse.ProtoReflect.Descriptor instead.
-func (*ConsoleCmdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsoleCmdRequest.ProtoReflect.Descriptor instead.
+func (*ConsoleCmdRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ConsoleCmdResponse) GetStatus() *Status {
+func (x *ConsoleCmdRequest) GetPrjId() string {
 	if x != nil {
-		return x.Status
+		return x.PrjId
 	}
-	return nil
+	return ""
 }
 
-func (x *ConsoleCmdResponse) GetCmd() []string {
+func (x *ConsoleCmdRequest) GetNode() string {
 	if x != nil {
-		return x.Cmd
+		return x.Node
 	}
-	return nil
+	return ""
 }
 
-type StatusResponse struct {
+func (x *ConsoleCmdRequest) GetShell() bool {
+	if x != nil {
+		return x.Shell
+	}
+	return false
+}
+
+type ProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  *Status                      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Name    string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Id      string                       `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	OpenAt  string                       `protobuf:"bytes,4,opt,name=openAt,proto3" json:"openAt,omitempty"`
-	Running bool                         `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
-	Nodes   []*StatusResponse_NodeStatus `protobuf:"bytes,10,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *ProjectRequest) Reset() {
+	*x = ProjectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1955,13 +2371,13 @@ func (x *StatusResponse) Reset() {
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *ProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*ProjectRequest) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ProjectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1973,65 +2389,30 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectRequest.ProtoReflect.Descriptor instead.
+func (*ProjectRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *StatusResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *StatusResponse) GetId() string {
+func (x *ProjectRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *StatusResponse) GetOpenAt() string {
-	if x != nil {
-		return x.OpenAt
-	}
-	return ""
-}
-
-func (x *StatusResponse) GetRunning() bool {
-	if x != nil {
-		return x.Running
-	}
-	return false
-}
-
-func (x *StatusResponse) GetNodes() []*StatusResponse_NodeStatus {
-	if x != nil {
-		return x.Nodes
-	}
-	return nil
-}
-
-type ConfigFilesResponse struct {
+type TopologyExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status                           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Source ConfigFilesResponse_Source        `protobuf:"varint,2,opt,name=source,proto3,enum=netem.ConfigFilesResponse_Source" json:"source,omitempty"`
-	Files  []*ConfigFilesResponse_ConfigFile `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	Id     string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format TopologyExportRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=netem.TopologyExportRequest_Format" json:"format,omitempty"`
+	State  bool                         `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *ConfigFilesResponse) Reset() {
-	*x = ConfigFilesResponse{}
+func (x *TopologyExportRequest) Reset() {
+	*x = TopologyExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,13 +2420,13 @@ func (x *ConfigFilesResponse) Reset() {
 	}
 }
 
-func (x *ConfigFilesResponse) String() string {
+func (x *TopologyExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigFilesResponse) ProtoMessage() {}
+func (*TopologyExportRequest) ProtoMessage() {}
 
-func (x *ConfigFilesResponse) ProtoReflect() protoreflect.Message {
+func (x *TopologyExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2057,43 +2438,43 @@ func (x *ConfigFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigFilesResponse.ProtoReflect.Descriptor instead.
-func (*ConfigFilesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopologyExportRequest.ProtoReflect.Descriptor instead.
+func (*TopologyExportRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ConfigFilesResponse) GetStatus() *Status {
+func (x *TopologyExportRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ConfigFilesResponse) GetSource() ConfigFilesResponse_Source {
+func (x *TopologyExportRequest) GetFormat() TopologyExportRequest_Format {
 	if x != nil {
-		return x.Source
+		return x.Format
 	}
-	return ConfigFilesResponse_ARCHIVE
+	return TopologyExportRequest_DOT
 }
 
-func (x *ConfigFilesResponse) GetFiles() []*ConfigFilesResponse_ConfigFile {
+func (x *TopologyExportRequest) GetState() bool {
 	if x != nil {
-		return x.Files
+		return x.State
 	}
-	return nil
+	return false
 }
 
-type PrjListResponse struct {
+type WNetworkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   *Status                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Projects []*PrjListResponse_Info `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *PrjListResponse) Reset() {
-	*x = PrjListResponse{}
+func (x *WNetworkRequest) Reset() {
+	*x = WNetworkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2101,13 +2482,13 @@ func (x *PrjListResponse) Reset() {
 	}
 }
 
-func (x *PrjListResponse) String() string {
+func (x *WNetworkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrjListResponse) ProtoMessage() {}
+func (*WNetworkRequest) ProtoMessage() {}
 
-func (x *PrjListResponse) ProtoReflect() protoreflect.Message {
+func (x *WNetworkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2119,36 +2500,36 @@ func (x *PrjListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrjListResponse.ProtoReflect.Descriptor instead.
-func (*PrjListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WNetworkRequest.ProtoReflect.Descriptor instead.
+func (*WNetworkRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PrjListResponse) GetStatus() *Status {
+func (x *WNetworkRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *PrjListResponse) GetProjects() []*PrjListResponse_Info {
+func (x *WNetworkRequest) GetData() []byte {
 	if x != nil {
-		return x.Projects
+		return x.Data
 	}
 	return nil
 }
 
-type PrjOpenResponse struct {
+type OpenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Id     string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *PrjOpenResponse) Reset() {
-	*x = PrjOpenResponse{}
+func (x *OpenRequest) Reset() {
+	*x = OpenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2156,13 +2537,13 @@ func (x *PrjOpenResponse) Reset() {
 	}
 }
 
-func (x *PrjOpenResponse) String() string {
+func (x *OpenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrjOpenResponse) ProtoMessage() {}
+func (*OpenRequest) ProtoMessage() {}
 
-func (x *PrjOpenResponse) ProtoReflect() protoreflect.Message {
+func (x *OpenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2174,36 +2555,36 @@ func (x *PrjOpenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrjOpenResponse.ProtoReflect.Descriptor instead.
-func (*PrjOpenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenRequest.ProtoReflect.Descriptor instead.
+func (*OpenRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_netem_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PrjOpenResponse) GetStatus() *Status {
+func (x *OpenRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PrjOpenResponse) GetId() string {
+func (x *OpenRequest) GetData() []byte {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type TopologyRunMsg_NodeMessages struct {
+type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Messages []string `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Code  StatusCode `protobuf:"varint,1,opt,name=code,proto3,enum=netem.StatusCode" json:"code,omitempty"`
+	Error string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TopologyRunMsg_NodeMessages) Reset() {
-	*x = TopologyRunMsg_NodeMessages{}
+func (x *Status) Reset() {
+	*x = Status{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2211,13 +2592,13 @@ func (x *TopologyRunMsg_NodeMessages) Reset() {
 	}
 }
 
-func (x *TopologyRunMsg_NodeMessages) String() string {
+func (x *Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopologyRunMsg_NodeMessages) ProtoMessage() {}
+func (*Status) ProtoMessage() {}
 
-func (x *TopologyRunMsg_NodeMessages) ProtoReflect() protoreflect.Message {
+func (x *Status) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,39 +2610,35 @@ func (x *TopologyRunMsg_NodeMessages) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopologyRunMsg_NodeMessages.ProtoReflect.Descriptor instead.
-func (*TopologyRunMsg_NodeMessages) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TopologyRunMsg_NodeMessages) GetName() string {
+func (x *Status) GetCode() StatusCode {
 	if x != nil {
-		return x.Name
+		return x.Code
 	}
-	return ""
+	return StatusCode_OK
 }
 
-func (x *TopologyRunMsg_NodeMessages) GetMessages() []string {
+func (x *Status) GetError() string {
 	if x != nil {
-		return x.Messages
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type LinkConfig_QoSConfig struct {
+type AckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loss   float32 `protobuf:"fixed32,3,opt,name=loss,proto3" json:"loss,omitempty"`
-	Delay  int32   `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
-	Jitter int32   `protobuf:"varint,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
-	Rate   int32   `protobuf:"varint,6,opt,name=rate,proto3" json:"rate,omitempty"`
-	Buffer float32 `protobuf:"fixed32,7,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *LinkConfig_QoSConfig) Reset() {
-	*x = LinkConfig_QoSConfig{}
+func (x *AckResponse) Reset() {
+	*x = AckResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_netem_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2269,13 +2646,13 @@ func (x *LinkConfig_QoSConfig) Reset() {
 	}
 }
 
-func (x *LinkConfig_QoSConfig) String() string {
+func (x *AckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkConfig_QoSConfig) ProtoMessage() {}
+func (*AckResponse) ProtoMessage() {}
 
-func (x *LinkConfig_QoSConfig) ProtoReflect() protoreflect.Message {
+func (x *AckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_netem_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2287,72 +2664,154 @@ func (x *LinkConfig_QoSConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkConfig_QoSConfig.ProtoReflect.Descriptor instead.
-func (*LinkConfig_QoSConfig) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use AckResponse.ProtoReflect.Descriptor instead.
+func (*AckResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *LinkConfig_QoSConfig) GetLoss() float32 {
+func (x *AckResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Loss
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-func (x *LinkConfig_QoSConfig) GetDelay() int32 {
+type FileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data   []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FileResponse) Reset() {
+	*x = FileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileResponse) ProtoMessage() {}
+
+func (x *FileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileResponse.ProtoReflect.Descriptor instead.
+func (*FileResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FileResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Delay
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-func (x *LinkConfig_QoSConfig) GetJitter() int32 {
+func (x *FileResponse) GetData() []byte {
 	if x != nil {
-		return x.Jitter
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *LinkConfig_QoSConfig) GetRate() int32 {
+type VersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Version string  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VersionResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Rate
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-func (x *LinkConfig_QoSConfig) GetBuffer() float32 {
+func (x *VersionResponse) GetVersion() string {
 	if x != nil {
-		return x.Buffer
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-type StatusResponse_IfStatus struct {
+type ConsoleCmdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	State IfState `protobuf:"varint,2,opt,name=state,proto3,enum=netem.IfState" json:"state,omitempty"`
+	Status *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cmd    []string `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
 }
 
-func (x *StatusResponse_IfStatus) Reset() {
-	*x = StatusResponse_IfStatus{}
+func (x *ConsoleCmdResponse) Reset() {
+	*x = ConsoleCmdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_netem_proto_msgTypes[28]
+		mi := &file_internal_proto_netem_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_IfStatus) String() string {
+func (x *ConsoleCmdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_IfStatus) ProtoMessage() {}
+func (*ConsoleCmdResponse) ProtoMessage() {}
 
-func (x *StatusResponse_IfStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_netem_proto_msgTypes[28]
+func (x *ConsoleCmdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,52 +2822,57 @@ func (x *StatusResponse_IfStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_IfStatus.ProtoReflect.Descriptor instead.
-func (*StatusResponse_IfStatus) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use ConsoleCmdResponse.ProtoReflect.Descriptor instead.
+func (*ConsoleCmdResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *StatusResponse_IfStatus) GetName() string {
+func (x *ConsoleCmdResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *StatusResponse_IfStatus) GetState() IfState {
+func (x *ConsoleCmdResponse) GetCmd() []string {
 	if x != nil {
-		return x.State
+		return x.Cmd
 	}
-	return IfState_UP
+	return nil
 }
 
-type StatusResponse_NodeStatus struct {
+type StatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Running    bool                       `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
-	Interfaces []*StatusResponse_IfStatus `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Status       *Status                             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name         string                              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id           string                              `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	OpenAt       string                              `protobuf:"bytes,4,opt,name=openAt,proto3" json:"openAt,omitempty"`
+	Running      bool                                `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	Nodes        []*StatusResponse_NodeStatus        `protobuf:"bytes,10,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	ProjectLinks []*StatusResponse_ProjectLinkStatus `protobuf:"bytes,11,rep,name=projectLinks,proto3" json:"projectLinks,omitempty"`
+	PortForwards []*StatusResponse_PortForwardStatus `protobuf:"bytes,12,rep,name=portForwards,proto3" json:"portForwards,omitempty"`
 }
 
-func (x *StatusResponse_NodeStatus) Reset() {
-	*x = StatusResponse_NodeStatus{}
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_netem_proto_msgTypes[29]
+		mi := &file_internal_proto_netem_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse_NodeStatus) String() string {
+func (x *StatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_NodeStatus) ProtoMessage() {}
+func (*StatusResponse) ProtoMessage() {}
 
-func (x *StatusResponse_NodeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_netem_proto_msgTypes[29]
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2419,30 +2883,1058 @@ func (x *StatusResponse_NodeStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_NodeStatus.ProtoReflect.Descriptor instead.
-func (*StatusResponse_NodeStatus) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{22, 1}
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *StatusResponse_NodeStatus) GetName() string {
+func (x *StatusResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *StatusResponse_NodeStatus) GetRunning() bool {
+func (x *StatusResponse) GetId() string {
 	if x != nil {
-		return x.Running
+		return x.Id
 	}
-	return false
+	return ""
+}
+
+func (x *StatusResponse) GetOpenAt() string {
+	if x != nil {
+		return x.OpenAt
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *StatusResponse) GetNodes() []*StatusResponse_NodeStatus {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetProjectLinks() []*StatusResponse_ProjectLinkStatus {
+	if x != nil {
+		return x.ProjectLinks
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetPortForwards() []*StatusResponse_PortForwardStatus {
+	if x != nil {
+		return x.PortForwards
+	}
+	return nil
+}
+
+type SnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    *Status                       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Snapshots []*SnapshotsResponse_Snapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *SnapshotsResponse) Reset() {
+	*x = SnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotsResponse) ProtoMessage() {}
+
+func (x *SnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SnapshotsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SnapshotsResponse) GetSnapshots() []*SnapshotsResponse_Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type AddressingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      *Status                          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Enabled     bool                             `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Assignments []*AddressingResponse_Assignment `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *AddressingResponse) Reset() {
+	*x = AddressingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressingResponse) ProtoMessage() {}
+
+func (x *AddressingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressingResponse.ProtoReflect.Descriptor instead.
+func (*AddressingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddressingResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *AddressingResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AddressingResponse) GetAssignments() []*AddressingResponse_Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ConfigFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status                           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Source ConfigFilesResponse_Source        `protobuf:"varint,2,opt,name=source,proto3,enum=netem.ConfigFilesResponse_Source" json:"source,omitempty"`
+	Files  []*ConfigFilesResponse_ConfigFile `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ConfigFilesResponse) Reset() {
+	*x = ConfigFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigFilesResponse) ProtoMessage() {}
+
+func (x *ConfigFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigFilesResponse.ProtoReflect.Descriptor instead.
+func (*ConfigFilesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfigFilesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ConfigFilesResponse) GetSource() ConfigFilesResponse_Source {
+	if x != nil {
+		return x.Source
+	}
+	return ConfigFilesResponse_ARCHIVE
+}
+
+func (x *ConfigFilesResponse) GetFiles() []*ConfigFilesResponse_ConfigFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type PrjListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   *Status                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Projects []*PrjListResponse_Info `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *PrjListResponse) Reset() {
+	*x = PrjListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrjListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrjListResponse) ProtoMessage() {}
+
+func (x *PrjListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrjListResponse.ProtoReflect.Descriptor instead.
+func (*PrjListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PrjListResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PrjListResponse) GetProjects() []*PrjListResponse_Info {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type PrjOpenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id     string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PrjOpenResponse) Reset() {
+	*x = PrjOpenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrjOpenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrjOpenResponse) ProtoMessage() {}
+
+func (x *PrjOpenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrjOpenResponse.ProtoReflect.Descriptor instead.
+func (*PrjOpenResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PrjOpenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PrjOpenResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TopologyRunMsg_NodeMessages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Messages []string `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *TopologyRunMsg_NodeMessages) Reset() {
+	*x = TopologyRunMsg_NodeMessages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyRunMsg_NodeMessages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyRunMsg_NodeMessages) ProtoMessage() {}
+
+func (x *TopologyRunMsg_NodeMessages) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyRunMsg_NodeMessages.ProtoReflect.Descriptor instead.
+func (*TopologyRunMsg_NodeMessages) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *TopologyRunMsg_NodeMessages) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopologyRunMsg_NodeMessages) GetMessages() []string {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type LinkConfig_QoSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loss   float32 `protobuf:"fixed32,3,opt,name=loss,proto3" json:"loss,omitempty"`
+	Delay  int32   `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+	Jitter int32   `protobuf:"varint,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Rate   int32   `protobuf:"varint,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Buffer float32 `protobuf:"fixed32,7,opt,name=buffer,proto3" json:"buffer,omitempty"`
+}
+
+func (x *LinkConfig_QoSConfig) Reset() {
+	*x = LinkConfig_QoSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkConfig_QoSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkConfig_QoSConfig) ProtoMessage() {}
+
+func (x *LinkConfig_QoSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkConfig_QoSConfig.ProtoReflect.Descriptor instead.
+func (*LinkConfig_QoSConfig) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *LinkConfig_QoSConfig) GetLoss() float32 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *LinkConfig_QoSConfig) GetDelay() int32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *LinkConfig_QoSConfig) GetJitter() int32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *LinkConfig_QoSConfig) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *LinkConfig_QoSConfig) GetBuffer() float32 {
+	if x != nil {
+		return x.Buffer
+	}
+	return 0
+}
+
+type StatusResponse_IfStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State IfState `protobuf:"varint,2,opt,name=state,proto3,enum=netem.IfState" json:"state,omitempty"`
+}
+
+func (x *StatusResponse_IfStatus) Reset() {
+	*x = StatusResponse_IfStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_IfStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_IfStatus) ProtoMessage() {}
+
+func (x *StatusResponse_IfStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_IfStatus.ProtoReflect.Descriptor instead.
+func (*StatusResponse_IfStatus) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *StatusResponse_IfStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusResponse_IfStatus) GetState() IfState {
+	if x != nil {
+		return x.State
+	}
+	return IfState_UP
+}
+
+type StatusResponse_Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *StatusResponse_Position) Reset() {
+	*x = StatusResponse_Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Position) ProtoMessage() {}
+
+func (x *StatusResponse_Position) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Position.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Position) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *StatusResponse_Position) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *StatusResponse_Position) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type StatusResponse_NodeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuPercent  float64 `protobuf:"fixed64,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryUsage uint64  `protobuf:"varint,2,opt,name=memoryUsage,proto3" json:"memoryUsage,omitempty"`
+	MemoryLimit uint64  `protobuf:"varint,3,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`
+	Pids        uint64  `protobuf:"varint,4,opt,name=pids,proto3" json:"pids,omitempty"`
+	RxBytes     uint64  `protobuf:"varint,5,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	TxBytes     uint64  `protobuf:"varint,6,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+}
+
+func (x *StatusResponse_NodeStats) Reset() {
+	*x = StatusResponse_NodeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_NodeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_NodeStats) ProtoMessage() {}
+
+func (x *StatusResponse_NodeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_NodeStats.ProtoReflect.Descriptor instead.
+func (*StatusResponse_NodeStats) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 2}
+}
+
+func (x *StatusResponse_NodeStats) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *StatusResponse_NodeStats) GetMemoryUsage() uint64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *StatusResponse_NodeStats) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *StatusResponse_NodeStats) GetPids() uint64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *StatusResponse_NodeStats) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *StatusResponse_NodeStats) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+type StatusResponse_NodeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Running     bool                       `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Description string                     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon        string                     `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Labels      map[string]string          `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Position    *StatusResponse_Position   `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
+	Stats       *StatusResponse_NodeStats  `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	Paused      bool                       `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	Interfaces  []*StatusResponse_IfStatus `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *StatusResponse_NodeStatus) Reset() {
+	*x = StatusResponse_NodeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_NodeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_NodeStatus) ProtoMessage() {}
+
+func (x *StatusResponse_NodeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_NodeStatus.ProtoReflect.Descriptor instead.
+func (*StatusResponse_NodeStatus) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 3}
+}
+
+func (x *StatusResponse_NodeStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusResponse_NodeStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *StatusResponse_NodeStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StatusResponse_NodeStatus) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *StatusResponse_NodeStatus) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StatusResponse_NodeStatus) GetPosition() *StatusResponse_Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *StatusResponse_NodeStatus) GetStats() *StatusResponse_NodeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *StatusResponse_NodeStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *StatusResponse_NodeStatus) GetInterfaces() []*StatusResponse_IfStatus {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type StatusResponse_ProjectLinkStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peer1     string `protobuf:"bytes,1,opt,name=peer1,proto3" json:"peer1,omitempty"`
+	Peer2     string `protobuf:"bytes,2,opt,name=peer2,proto3" json:"peer2,omitempty"`
+	Connected bool   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *StatusResponse_ProjectLinkStatus) Reset() {
+	*x = StatusResponse_ProjectLinkStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_ProjectLinkStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_ProjectLinkStatus) ProtoMessage() {}
+
+func (x *StatusResponse_ProjectLinkStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_ProjectLinkStatus.ProtoReflect.Descriptor instead.
+func (*StatusResponse_ProjectLinkStatus) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 4}
+}
+
+func (x *StatusResponse_ProjectLinkStatus) GetPeer1() string {
+	if x != nil {
+		return x.Peer1
+	}
+	return ""
+}
+
+func (x *StatusResponse_ProjectLinkStatus) GetPeer2() string {
+	if x != nil {
+		return x.Peer2
+	}
+	return ""
+}
+
+func (x *StatusResponse_ProjectLinkStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type StatusResponse_PortForwardStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Port string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *StatusResponse_PortForwardStatus) Reset() {
+	*x = StatusResponse_PortForwardStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_PortForwardStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_PortForwardStatus) ProtoMessage() {}
+
+func (x *StatusResponse_PortForwardStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_PortForwardStatus.ProtoReflect.Descriptor instead.
+func (*StatusResponse_PortForwardStatus) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{31, 5}
+}
+
+func (x *StatusResponse_PortForwardStatus) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *StatusResponse_PortForwardStatus) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+type SnapshotsResponse_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *SnapshotsResponse_Snapshot) Reset() {
+	*x = SnapshotsResponse_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotsResponse_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotsResponse_Snapshot) ProtoMessage() {}
+
+func (x *SnapshotsResponse_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotsResponse_Snapshot.ProtoReflect.Descriptor instead.
+func (*SnapshotsResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *SnapshotsResponse_Snapshot) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SnapshotsResponse_Snapshot) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SnapshotsResponse_Snapshot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AddressingResponse_Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node      string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Network   string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Ipv4      string `protobuf:"bytes,4,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6      string `protobuf:"bytes,5,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+}
+
+func (x *AddressingResponse_Assignment) Reset() {
+	*x = AddressingResponse_Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_netem_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressingResponse_Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressingResponse_Assignment) ProtoMessage() {}
+
+func (x *AddressingResponse_Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_netem_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressingResponse_Assignment.ProtoReflect.Descriptor instead.
+func (*AddressingResponse_Assignment) Descriptor() ([]byte, []int) {
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *AddressingResponse_Assignment) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *AddressingResponse_Assignment) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *AddressingResponse_Assignment) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AddressingResponse_Assignment) GetIpv4() string {
+	if x != nil {
+		return x.Ipv4
+	}
+	return ""
 }
 
-func (x *StatusResponse_NodeStatus) GetInterfaces() []*StatusResponse_IfStatus {
+func (x *AddressingResponse_Assignment) GetIpv6() string {
 	if x != nil {
-		return x.Interfaces
+		return x.Ipv6
 	}
-	return nil
+	return ""
 }
 
 type ConfigFilesResponse_ConfigFile struct {
@@ -2457,7 +3949,7 @@ type ConfigFilesResponse_ConfigFile struct {
 func (x *ConfigFilesResponse_ConfigFile) Reset() {
 	*x = ConfigFilesResponse_ConfigFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_netem_proto_msgTypes[30]
+		mi := &file_internal_proto_netem_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2470,7 +3962,7 @@ func (x *ConfigFilesResponse_ConfigFile) String() string {
 func (*ConfigFilesResponse_ConfigFile) ProtoMessage() {}
 
 func (x *ConfigFilesResponse_ConfigFile) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_netem_proto_msgTypes[30]
+	mi := &file_internal_proto_netem_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,7 +3975,7 @@ func (x *ConfigFilesResponse_ConfigFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigFilesResponse_ConfigFile.ProtoReflect.Descriptor instead.
 func (*ConfigFilesResponse_ConfigFile) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{23, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *ConfigFilesResponse_ConfigFile) GetName() string {
@@ -2513,7 +4005,7 @@ type PrjListResponse_Info struct {
 func (x *PrjListResponse_Info) Reset() {
 	*x = PrjListResponse_Info{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_netem_proto_msgTypes[31]
+		mi := &file_internal_proto_netem_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2526,7 +4018,7 @@ func (x *PrjListResponse_Info) String() string {
 func (*PrjListResponse_Info) ProtoMessage() {}
 
 func (x *PrjListResponse_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_netem_proto_msgTypes[31]
+	mi := &file_internal_proto_netem_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,7 +4031,7 @@ func (x *PrjListResponse_Info) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrjListResponse_Info.ProtoReflect.Descriptor instead.
 func (*PrjListResponse_Info) Descriptor() ([]byte, []int) {
-	return file_internal_proto_netem_proto_rawDescGZIP(), []int{24, 0}
+	return file_internal_proto_netem_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *PrjListResponse_Info) GetId() string {
@@ -2595,340 +4087,564 @@ var file_internal_proto_netem_proto_rawDesc = []byte{
 	0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53,
 	0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x45, 0x52,
 	0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x09,
-	0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x22, 0xb3, 0x01, 0x0a, 0x07, 0x43, 0x6f,
-	0x70, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79,
-	0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41,
-	0x54, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x22,
-	0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2a,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e,
-	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x2e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x09,
-	0x0a, 0x05, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
-	0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x22, 0x85, 0x01, 0x0a,
-	0x0d, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2d,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6e,
-	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x72, 0x76, 0x4d,
-	0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x31, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44,
-	0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10,
-	0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x03, 0x22, 0x8f, 0x03, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
-	0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f,
-	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x46, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f, 0x70, 0x6f,
-	0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x03,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x10, 0x04,
-	0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
-	0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
-	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x53, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
-	0x44, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x44,
-	0x45, 0x5f, 0x52, 0x4d, 0x10, 0x09, 0x22, 0x9b, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x44, 0x69,
+	0x61, 0x6c, 0x43, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x44,
+	0x69, 0x61, 0x6c, 0x43, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x28, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x44,
+	0x69, 0x61, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x44, 0x69, 0x61, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03,
+	0x22, 0xb3, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x25, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x53,
+	0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x02, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x31, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x22, 0x77, 0x0a, 0x0a, 0x4c,
+	0x6f, 0x67, 0x73, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x4c, 0x6f, 0x67, 0x73, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x29, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x02, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x33, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x8f, 0x03, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0x2f, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
-	0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41,
-	0x54, 0x41, 0x10, 0x02, 0x22, 0xa4, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x2e, 0x43,
 	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
-	0x4a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49, 0x44, 0x47,
-	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49,
-	0x44, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x22, 0xa5, 0x02, 0x0a, 0x0a,
-	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65,
-	0x65, 0x72, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x31,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x31, 0x71,
-	0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x51, 0x6f, 0x53, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x31, 0x71, 0x6f, 0x73, 0x12,
-	0x37, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x32, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x51, 0x6f, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
-	0x70, 0x65, 0x65, 0x72, 0x32, 0x71, 0x6f, 0x73, 0x1a, 0x79, 0x0a, 0x09, 0x51, 0x6f, 0x53, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
-	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
-	0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
-	0x79, 0x6e, 0x63, 0x22, 0x7e, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x66, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6e,
-	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
-	0x37, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x46, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50,
+	0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x41,
+	0x44, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x53, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4d, 0x10, 0x09, 0x22, 0x9b, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67,
+	0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x2f, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x22, 0xa4, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67,
+	0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x4a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x49,
+	0x44, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42,
+	0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x22, 0xe1, 0x02,
+	0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
+	0x31, 0x71, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x51, 0x6f,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x31, 0x71, 0x6f,
+	0x73, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x32, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x51, 0x6f, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x32, 0x71, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x79, 0x0a, 0x09, 0x51, 0x6f, 0x53, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x22, 0x5e, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e,
+	0x63, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6a, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6a, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x76, 0x6e, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74,
+	0x75, 0x22, 0x75, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x74,
+	0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x22, 0x7e, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
 	0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72,
-	0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x22, 0x20, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x35, 0x0a, 0x0f, 0x57, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x45, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x0c, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x66, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x66,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x66, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x37, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x7d, 0x0a,
+	0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x7e, 0x0a, 0x12,
+	0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x53, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x6a, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c,
+	0x6c, 0x22, 0x20, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x24, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f,
+	0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x56, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x35, 0x0a, 0x0f, 0x57, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a,
+	0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x0b, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74,
 	0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
-	0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x22, 0x49, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x0f,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x22, 0x87, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65,
-	0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x1a, 0x44, 0x0a, 0x08, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x7a, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x66,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x4d, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x22,
+	0xe2, 0x09, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x1a, 0x44, 0x0a, 0x08, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x26, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x1a,
+	0xb7, 0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x70, 0x69, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0xbc, 0x03, 0x0a, 0x0a, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x3e, 0x0a,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65,
+	0x65, 0x72, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x3b, 0x0a, 0x11, 0x50, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74,
 	0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65,
-	0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x22, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6a, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6a, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x42, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x0f, 0x50,
-	0x72, 0x6a, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x1f, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x1b, 0x0a, 0x07, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57,
-	0x4e, 0x10, 0x01, 0x32, 0xb6, 0x0e, 0x0a, 0x05, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a,
-	0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65,
-	0x6d, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x6c,
-	0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x72, 0x76, 0x4d,
-	0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x50, 0x72, 0x6a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x65, 0x6e,
-	0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6a,
-	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41,
-	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x15,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65,
-	0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x45, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x1a, 0x46, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x46, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x0a, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76,
+	0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x22, 0x8e, 0x02,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x22, 0x0a, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x22, 0xb5,
+	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x1a, 0x42, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x0f, 0x50, 0x72, 0x6a, 0x4f, 0x70, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x2a, 0x1f, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x2a, 0x1b, 0x0a, 0x07, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x06, 0x0a, 0x02,
+	0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x32, 0x94,
+	0x15, 0x0a, 0x05, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x45, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6a, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x2e, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6a, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x12, 0x15, 0x2e, 0x6e, 0x65,
 	0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x2e,
-	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a,
-	0x0f, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x57, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74,
-	0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3c, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
-	0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75,
-	0x6e, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
-	0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
-	0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x6e, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x14, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x6e, 0x65,
 	0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a,
-	0x09, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74,
-	0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x70,
-	0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x57, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x52, 0x75, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x10, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x12,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x12,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c,
+	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09,
+	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65,
 	0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
 	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x49, 0x66,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x61,
-	0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75,
-	0x72, 0x65, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0c,
-	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x2e, 0x6e,
-	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x6e,
-	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x34, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x54, 0x6f, 0x12, 0x0e,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x12,
+	0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x12,
+	0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x49, 0x66, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
 	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x12, 0x18, 0x2e, 0x6e, 0x65,
-	0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63, 0x12, 0x11,
-	0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6c, 0x74, 0x4d, 0x73,
-	0x67, 0x1a, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x72,
-	0x76, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x4c, 0x69,
-	0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34,
+	0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x54, 0x6f, 0x12, 0x0e, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x6e,
 	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x64, 0x64, 0x12, 0x12, 0x2e,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x65,
+	0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63, 0x12, 0x11, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x1a,
+	0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x72, 0x76, 0x4d,
+	0x73, 0x67, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6e,
+	0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x44,
+	0x69, 0x61, 0x6c, 0x12, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x69, 0x61, 0x6c,
+	0x43, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x44,
+	0x69, 0x61, 0x6c, 0x53, 0x72, 0x76, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x36, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e,
 	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x44,
-	0x65, 0x6c, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x41,
+	0x64, 0x64, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x72, 0x6f, 0x79, 0x33,
-	0x31, 0x2f, 0x67, 0x6f, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07,
+	0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x12, 0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4a, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x72, 0x6f, 0x79, 0x33, 0x31, 0x2f, 0x67, 0x6f, 0x6e, 0x65, 0x74,
+	0x65, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2943,146 +4659,210 @@ func file_internal_proto_netem_proto_rawDescGZIP() []byte {
 	return file_internal_proto_netem_proto_rawDescData
 }
 
-var file_internal_proto_netem_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_internal_proto_netem_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_internal_proto_netem_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_internal_proto_netem_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_internal_proto_netem_proto_goTypes = []interface{}{
-	(StatusCode)(0),                        // 0: netem.StatusCode
-	(IfState)(0),                           // 1: netem.IfState
-	(ExecCltMsg_Code)(0),                   // 2: netem.ExecCltMsg.Code
-	(ExecSrvMsg_Code)(0),                   // 3: netem.ExecSrvMsg.Code
-	(CopyMsg_Code)(0),                      // 4: netem.CopyMsg.Code
-	(PullSrvMsg_Code)(0),                   // 5: netem.PullSrvMsg.Code
-	(CaptureSrvMsg_Code)(0),                // 6: netem.CaptureSrvMsg.Code
-	(TopologyRunMsg_Code)(0),               // 7: netem.TopologyRunMsg.Code
-	(ProjectSaveMsg_Code)(0),               // 8: netem.ProjectSaveMsg.Code
-	(ProjectCloseMsg_Code)(0),              // 9: netem.ProjectCloseMsg.Code
-	(ConfigFilesResponse_Source)(0),        // 10: netem.ConfigFilesResponse.Source
-	(*ExecCltMsg)(nil),                     // 11: netem.ExecCltMsg
-	(*ExecSrvMsg)(nil),                     // 12: netem.ExecSrvMsg
-	(*CopyMsg)(nil),                        // 13: netem.CopyMsg
-	(*PullSrvMsg)(nil),                     // 14: netem.PullSrvMsg
-	(*CaptureSrvMsg)(nil),                  // 15: netem.CaptureSrvMsg
-	(*TopologyRunMsg)(nil),                 // 16: netem.TopologyRunMsg
-	(*ProjectSaveMsg)(nil),                 // 17: netem.ProjectSaveMsg
-	(*ProjectCloseMsg)(nil),                // 18: netem.ProjectCloseMsg
-	(*LinkConfig)(nil),                     // 19: netem.LinkConfig
-	(*LinkRequest)(nil),                    // 20: netem.LinkRequest
-	(*NodeIfStateRequest)(nil),             // 21: netem.NodeIfStateRequest
-	(*NodeInterfaceRequest)(nil),           // 22: netem.NodeInterfaceRequest
-	(*NodeRequest)(nil),                    // 23: netem.NodeRequest
-	(*ConsoleCmdRequest)(nil),              // 24: netem.ConsoleCmdRequest
-	(*ProjectRequest)(nil),                 // 25: netem.ProjectRequest
-	(*WNetworkRequest)(nil),                // 26: netem.WNetworkRequest
-	(*OpenRequest)(nil),                    // 27: netem.OpenRequest
-	(*Status)(nil),                         // 28: netem.Status
-	(*AckResponse)(nil),                    // 29: netem.AckResponse
-	(*FileResponse)(nil),                   // 30: netem.FileResponse
-	(*VersionResponse)(nil),                // 31: netem.VersionResponse
-	(*ConsoleCmdResponse)(nil),             // 32: netem.ConsoleCmdResponse
-	(*StatusResponse)(nil),                 // 33: netem.StatusResponse
-	(*ConfigFilesResponse)(nil),            // 34: netem.ConfigFilesResponse
-	(*PrjListResponse)(nil),                // 35: netem.PrjListResponse
-	(*PrjOpenResponse)(nil),                // 36: netem.PrjOpenResponse
-	(*TopologyRunMsg_NodeMessages)(nil),    // 37: netem.TopologyRunMsg.NodeMessages
-	(*LinkConfig_QoSConfig)(nil),           // 38: netem.LinkConfig.QoSConfig
-	(*StatusResponse_IfStatus)(nil),        // 39: netem.StatusResponse.IfStatus
-	(*StatusResponse_NodeStatus)(nil),      // 40: netem.StatusResponse.NodeStatus
-	(*ConfigFilesResponse_ConfigFile)(nil), // 41: netem.ConfigFilesResponse.ConfigFile
-	(*PrjListResponse_Info)(nil),           // 42: netem.PrjListResponse.Info
-	(*emptypb.Empty)(nil),                  // 43: google.protobuf.Empty
+	(StatusCode)(0),                          // 0: netem.StatusCode
+	(IfState)(0),                             // 1: netem.IfState
+	(ExecCltMsg_Code)(0),                     // 2: netem.ExecCltMsg.Code
+	(ExecSrvMsg_Code)(0),                     // 3: netem.ExecSrvMsg.Code
+	(DialCltMsg_Code)(0),                     // 4: netem.DialCltMsg.Code
+	(DialSrvMsg_Code)(0),                     // 5: netem.DialSrvMsg.Code
+	(CopyMsg_Code)(0),                        // 6: netem.CopyMsg.Code
+	(PullSrvMsg_Code)(0),                     // 7: netem.PullSrvMsg.Code
+	(CaptureSrvMsg_Code)(0),                  // 8: netem.CaptureSrvMsg.Code
+	(LogsSrvMsg_Code)(0),                     // 9: netem.LogsSrvMsg.Code
+	(NodeEventMsg_Code)(0),                   // 10: netem.NodeEventMsg.Code
+	(TopologyRunMsg_Code)(0),                 // 11: netem.TopologyRunMsg.Code
+	(ProjectSaveMsg_Code)(0),                 // 12: netem.ProjectSaveMsg.Code
+	(ProjectCloseMsg_Code)(0),                // 13: netem.ProjectCloseMsg.Code
+	(TopologyExportRequest_Format)(0),        // 14: netem.TopologyExportRequest.Format
+	(ConfigFilesResponse_Source)(0),          // 15: netem.ConfigFilesResponse.Source
+	(*ExecCltMsg)(nil),                       // 16: netem.ExecCltMsg
+	(*ExecSrvMsg)(nil),                       // 17: netem.ExecSrvMsg
+	(*DialCltMsg)(nil),                       // 18: netem.DialCltMsg
+	(*DialSrvMsg)(nil),                       // 19: netem.DialSrvMsg
+	(*CopyMsg)(nil),                          // 20: netem.CopyMsg
+	(*PullSrvMsg)(nil),                       // 21: netem.PullSrvMsg
+	(*CaptureSrvMsg)(nil),                    // 22: netem.CaptureSrvMsg
+	(*LogsSrvMsg)(nil),                       // 23: netem.LogsSrvMsg
+	(*NodeEventMsg)(nil),                     // 24: netem.NodeEventMsg
+	(*TopologyRunMsg)(nil),                   // 25: netem.TopologyRunMsg
+	(*ProjectSaveMsg)(nil),                   // 26: netem.ProjectSaveMsg
+	(*ProjectCloseMsg)(nil),                  // 27: netem.ProjectCloseMsg
+	(*LinkConfig)(nil),                       // 28: netem.LinkConfig
+	(*LinkRequest)(nil),                      // 29: netem.LinkRequest
+	(*RemoteLinkRequest)(nil),                // 30: netem.RemoteLinkRequest
+	(*RemoteLinkResponse)(nil),               // 31: netem.RemoteLinkResponse
+	(*NodeIfStateRequest)(nil),               // 32: netem.NodeIfStateRequest
+	(*NodeInterfaceRequest)(nil),             // 33: netem.NodeInterfaceRequest
+	(*NodeRequest)(nil),                      // 34: netem.NodeRequest
+	(*NodeLogsRequest)(nil),                  // 35: netem.NodeLogsRequest
+	(*PortForwardRequest)(nil),               // 36: netem.PortForwardRequest
+	(*ConsoleCmdRequest)(nil),                // 37: netem.ConsoleCmdRequest
+	(*ProjectRequest)(nil),                   // 38: netem.ProjectRequest
+	(*TopologyExportRequest)(nil),            // 39: netem.TopologyExportRequest
+	(*WNetworkRequest)(nil),                  // 40: netem.WNetworkRequest
+	(*OpenRequest)(nil),                      // 41: netem.OpenRequest
+	(*Status)(nil),                           // 42: netem.Status
+	(*AckResponse)(nil),                      // 43: netem.AckResponse
+	(*FileResponse)(nil),                     // 44: netem.FileResponse
+	(*VersionResponse)(nil),                  // 45: netem.VersionResponse
+	(*ConsoleCmdResponse)(nil),               // 46: netem.ConsoleCmdResponse
+	(*StatusResponse)(nil),                   // 47: netem.StatusResponse
+	(*SnapshotsResponse)(nil),                // 48: netem.SnapshotsResponse
+	(*AddressingResponse)(nil),               // 49: netem.AddressingResponse
+	(*ConfigFilesResponse)(nil),              // 50: netem.ConfigFilesResponse
+	(*PrjListResponse)(nil),                  // 51: netem.PrjListResponse
+	(*PrjOpenResponse)(nil),                  // 52: netem.PrjOpenResponse
+	(*TopologyRunMsg_NodeMessages)(nil),      // 53: netem.TopologyRunMsg.NodeMessages
+	(*LinkConfig_QoSConfig)(nil),             // 54: netem.LinkConfig.QoSConfig
+	(*StatusResponse_IfStatus)(nil),          // 55: netem.StatusResponse.IfStatus
+	(*StatusResponse_Position)(nil),          // 56: netem.StatusResponse.Position
+	(*StatusResponse_NodeStats)(nil),         // 57: netem.StatusResponse.NodeStats
+	(*StatusResponse_NodeStatus)(nil),        // 58: netem.StatusResponse.NodeStatus
+	(*StatusResponse_ProjectLinkStatus)(nil), // 59: netem.StatusResponse.ProjectLinkStatus
+	(*StatusResponse_PortForwardStatus)(nil), // 60: netem.StatusResponse.PortForwardStatus
+	nil,                                      // 61: netem.StatusResponse.NodeStatus.LabelsEntry
+	(*SnapshotsResponse_Snapshot)(nil),       // 62: netem.SnapshotsResponse.Snapshot
+	(*AddressingResponse_Assignment)(nil),    // 63: netem.AddressingResponse.Assignment
+	(*ConfigFilesResponse_ConfigFile)(nil),   // 64: netem.ConfigFilesResponse.ConfigFile
+	(*PrjListResponse_Info)(nil),             // 65: netem.PrjListResponse.Info
+	(*emptypb.Empty)(nil),                    // 66: google.protobuf.Empty
 }
 var file_internal_proto_netem_proto_depIdxs = []int32{
 	2,  // 0: netem.ExecCltMsg.code:type_name -> netem.ExecCltMsg.Code
 	3,  // 1: netem.ExecSrvMsg.code:type_name -> netem.ExecSrvMsg.Code
-	4,  // 2: netem.CopyMsg.code:type_name -> netem.CopyMsg.Code
-	5,  // 3: netem.PullSrvMsg.code:type_name -> netem.PullSrvMsg.Code
-	6,  // 4: netem.CaptureSrvMsg.code:type_name -> netem.CaptureSrvMsg.Code
-	7,  // 5: netem.TopologyRunMsg.code:type_name -> netem.TopologyRunMsg.Code
-	37, // 6: netem.TopologyRunMsg.nodeMessages:type_name -> netem.TopologyRunMsg.NodeMessages
-	8,  // 7: netem.ProjectSaveMsg.code:type_name -> netem.ProjectSaveMsg.Code
-	9,  // 8: netem.ProjectCloseMsg.code:type_name -> netem.ProjectCloseMsg.Code
-	38, // 9: netem.LinkConfig.peer1qos:type_name -> netem.LinkConfig.QoSConfig
-	38, // 10: netem.LinkConfig.peer2qos:type_name -> netem.LinkConfig.QoSConfig
-	19, // 11: netem.LinkRequest.link:type_name -> netem.LinkConfig
-	1,  // 12: netem.NodeIfStateRequest.state:type_name -> netem.IfState
-	0,  // 13: netem.Status.code:type_name -> netem.StatusCode
-	28, // 14: netem.AckResponse.status:type_name -> netem.Status
-	28, // 15: netem.FileResponse.status:type_name -> netem.Status
-	28, // 16: netem.VersionResponse.status:type_name -> netem.Status
-	28, // 17: netem.ConsoleCmdResponse.status:type_name -> netem.Status
-	28, // 18: netem.StatusResponse.status:type_name -> netem.Status
-	40, // 19: netem.StatusResponse.nodes:type_name -> netem.StatusResponse.NodeStatus
-	28, // 20: netem.ConfigFilesResponse.status:type_name -> netem.Status
-	10, // 21: netem.ConfigFilesResponse.source:type_name -> netem.ConfigFilesResponse.Source
-	41, // 22: netem.ConfigFilesResponse.files:type_name -> netem.ConfigFilesResponse.ConfigFile
-	28, // 23: netem.PrjListResponse.status:type_name -> netem.Status
-	42, // 24: netem.PrjListResponse.projects:type_name -> netem.PrjListResponse.Info
-	28, // 25: netem.PrjOpenResponse.status:type_name -> netem.Status
-	1,  // 26: netem.StatusResponse.IfStatus.state:type_name -> netem.IfState
-	39, // 27: netem.StatusResponse.NodeStatus.interfaces:type_name -> netem.StatusResponse.IfStatus
-	43, // 28: netem.Netem.ServerGetVersion:input_type -> google.protobuf.Empty
-	43, // 29: netem.Netem.ServerPullImages:input_type -> google.protobuf.Empty
-	43, // 30: netem.Netem.ServerCleanContainers:input_type -> google.protobuf.Empty
-	43, // 31: netem.Netem.ProjectGetMany:input_type -> google.protobuf.Empty
-	27, // 32: netem.Netem.ProjectOpen:input_type -> netem.OpenRequest
-	25, // 33: netem.Netem.ProjectClose:input_type -> netem.ProjectRequest
-	25, // 34: netem.Netem.ProjectSave:input_type -> netem.ProjectRequest
-	25, // 35: netem.Netem.ProjectGetNodeConfigs:input_type -> netem.ProjectRequest
-	25, // 36: netem.Netem.ProjectGetStatus:input_type -> netem.ProjectRequest
-	25, // 37: netem.Netem.ReadNetworkFile:input_type -> netem.ProjectRequest
-	26, // 38: netem.Netem.WriteNetworkFile:input_type -> netem.WNetworkRequest
-	25, // 39: netem.Netem.TopologyCheck:input_type -> netem.ProjectRequest
-	25, // 40: netem.Netem.TopologyReload:input_type -> netem.ProjectRequest
-	25, // 41: netem.Netem.TopologyRun:input_type -> netem.ProjectRequest
-	25, // 42: netem.Netem.TopologyStartAll:input_type -> netem.ProjectRequest
-	25, // 43: netem.Netem.TopologyStopAll:input_type -> netem.ProjectRequest
-	23, // 44: netem.Netem.NodeReadConfigFiles:input_type -> netem.NodeRequest
-	23, // 45: netem.Netem.NodeStart:input_type -> netem.NodeRequest
-	23, // 46: netem.Netem.NodeStop:input_type -> netem.NodeRequest
-	23, // 47: netem.Netem.NodeRestart:input_type -> netem.NodeRequest
-	21, // 48: netem.Netem.NodeSetIfState:input_type -> netem.NodeIfStateRequest
-	22, // 49: netem.Netem.NodeCapture:input_type -> netem.NodeInterfaceRequest
-	13, // 50: netem.Netem.NodeCopyFrom:input_type -> netem.CopyMsg
-	13, // 51: netem.Netem.NodeCopyTo:input_type -> netem.CopyMsg
-	24, // 52: netem.Netem.NodeGetConsoleCmd:input_type -> netem.ConsoleCmdRequest
-	11, // 53: netem.Netem.NodeExec:input_type -> netem.ExecCltMsg
-	20, // 54: netem.Netem.LinkUpdate:input_type -> netem.LinkRequest
-	20, // 55: netem.Netem.LinkAdd:input_type -> netem.LinkRequest
-	20, // 56: netem.Netem.LinkDel:input_type -> netem.LinkRequest
-	31, // 57: netem.Netem.ServerGetVersion:output_type -> netem.VersionResponse
-	14, // 58: netem.Netem.ServerPullImages:output_type -> netem.PullSrvMsg
-	29, // 59: netem.Netem.ServerCleanContainers:output_type -> netem.AckResponse
-	35, // 60: netem.Netem.ProjectGetMany:output_type -> netem.PrjListResponse
-	36, // 61: netem.Netem.ProjectOpen:output_type -> netem.PrjOpenResponse
-	18, // 62: netem.Netem.ProjectClose:output_type -> netem.ProjectCloseMsg
-	17, // 63: netem.Netem.ProjectSave:output_type -> netem.ProjectSaveMsg
-	30, // 64: netem.Netem.ProjectGetNodeConfigs:output_type -> netem.FileResponse
-	33, // 65: netem.Netem.ProjectGetStatus:output_type -> netem.StatusResponse
-	30, // 66: netem.Netem.ReadNetworkFile:output_type -> netem.FileResponse
-	29, // 67: netem.Netem.WriteNetworkFile:output_type -> netem.AckResponse
-	29, // 68: netem.Netem.TopologyCheck:output_type -> netem.AckResponse
-	16, // 69: netem.Netem.TopologyReload:output_type -> netem.TopologyRunMsg
-	16, // 70: netem.Netem.TopologyRun:output_type -> netem.TopologyRunMsg
-	29, // 71: netem.Netem.TopologyStartAll:output_type -> netem.AckResponse
-	29, // 72: netem.Netem.TopologyStopAll:output_type -> netem.AckResponse
-	34, // 73: netem.Netem.NodeReadConfigFiles:output_type -> netem.ConfigFilesResponse
-	29, // 74: netem.Netem.NodeStart:output_type -> netem.AckResponse
-	29, // 75: netem.Netem.NodeStop:output_type -> netem.AckResponse
-	29, // 76: netem.Netem.NodeRestart:output_type -> netem.AckResponse
-	29, // 77: netem.Netem.NodeSetIfState:output_type -> netem.AckResponse
-	15, // 78: netem.Netem.NodeCapture:output_type -> netem.CaptureSrvMsg
-	13, // 79: netem.Netem.NodeCopyFrom:output_type -> netem.CopyMsg
-	29, // 80: netem.Netem.NodeCopyTo:output_type -> netem.AckResponse
-	32, // 81: netem.Netem.NodeGetConsoleCmd:output_type -> netem.ConsoleCmdResponse
-	12, // 82: netem.Netem.NodeExec:output_type -> netem.ExecSrvMsg
-	29, // 83: netem.Netem.LinkUpdate:output_type -> netem.AckResponse
-	29, // 84: netem.Netem.LinkAdd:output_type -> netem.AckResponse
-	29, // 85: netem.Netem.LinkDel:output_type -> netem.AckResponse
-	57, // [57:86] is the sub-list for method output_type
-	28, // [28:57] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	4,  // 2: netem.DialCltMsg.code:type_name -> netem.DialCltMsg.Code
+	5,  // 3: netem.DialSrvMsg.code:type_name -> netem.DialSrvMsg.Code
+	6,  // 4: netem.CopyMsg.code:type_name -> netem.CopyMsg.Code
+	7,  // 5: netem.PullSrvMsg.code:type_name -> netem.PullSrvMsg.Code
+	8,  // 6: netem.CaptureSrvMsg.code:type_name -> netem.CaptureSrvMsg.Code
+	9,  // 7: netem.LogsSrvMsg.code:type_name -> netem.LogsSrvMsg.Code
+	10, // 8: netem.NodeEventMsg.code:type_name -> netem.NodeEventMsg.Code
+	11, // 9: netem.TopologyRunMsg.code:type_name -> netem.TopologyRunMsg.Code
+	53, // 10: netem.TopologyRunMsg.nodeMessages:type_name -> netem.TopologyRunMsg.NodeMessages
+	12, // 11: netem.ProjectSaveMsg.code:type_name -> netem.ProjectSaveMsg.Code
+	13, // 12: netem.ProjectCloseMsg.code:type_name -> netem.ProjectCloseMsg.Code
+	54, // 13: netem.LinkConfig.peer1qos:type_name -> netem.LinkConfig.QoSConfig
+	54, // 14: netem.LinkConfig.peer2qos:type_name -> netem.LinkConfig.QoSConfig
+	28, // 15: netem.LinkRequest.link:type_name -> netem.LinkConfig
+	42, // 16: netem.RemoteLinkResponse.status:type_name -> netem.Status
+	1,  // 17: netem.NodeIfStateRequest.state:type_name -> netem.IfState
+	14, // 18: netem.TopologyExportRequest.format:type_name -> netem.TopologyExportRequest.Format
+	0,  // 19: netem.Status.code:type_name -> netem.StatusCode
+	42, // 20: netem.AckResponse.status:type_name -> netem.Status
+	42, // 21: netem.FileResponse.status:type_name -> netem.Status
+	42, // 22: netem.VersionResponse.status:type_name -> netem.Status
+	42, // 23: netem.ConsoleCmdResponse.status:type_name -> netem.Status
+	42, // 24: netem.StatusResponse.status:type_name -> netem.Status
+	58, // 25: netem.StatusResponse.nodes:type_name -> netem.StatusResponse.NodeStatus
+	59, // 26: netem.StatusResponse.projectLinks:type_name -> netem.StatusResponse.ProjectLinkStatus
+	60, // 27: netem.StatusResponse.portForwards:type_name -> netem.StatusResponse.PortForwardStatus
+	42, // 28: netem.SnapshotsResponse.status:type_name -> netem.Status
+	62, // 29: netem.SnapshotsResponse.snapshots:type_name -> netem.SnapshotsResponse.Snapshot
+	42, // 30: netem.AddressingResponse.status:type_name -> netem.Status
+	63, // 31: netem.AddressingResponse.assignments:type_name -> netem.AddressingResponse.Assignment
+	42, // 32: netem.ConfigFilesResponse.status:type_name -> netem.Status
+	15, // 33: netem.ConfigFilesResponse.source:type_name -> netem.ConfigFilesResponse.Source
+	64, // 34: netem.ConfigFilesResponse.files:type_name -> netem.ConfigFilesResponse.ConfigFile
+	42, // 35: netem.PrjListResponse.status:type_name -> netem.Status
+	65, // 36: netem.PrjListResponse.projects:type_name -> netem.PrjListResponse.Info
+	42, // 37: netem.PrjOpenResponse.status:type_name -> netem.Status
+	1,  // 38: netem.StatusResponse.IfStatus.state:type_name -> netem.IfState
+	61, // 39: netem.StatusResponse.NodeStatus.labels:type_name -> netem.StatusResponse.NodeStatus.LabelsEntry
+	56, // 40: netem.StatusResponse.NodeStatus.position:type_name -> netem.StatusResponse.Position
+	57, // 41: netem.StatusResponse.NodeStatus.stats:type_name -> netem.StatusResponse.NodeStats
+	55, // 42: netem.StatusResponse.NodeStatus.interfaces:type_name -> netem.StatusResponse.IfStatus
+	66, // 43: netem.Netem.ServerGetVersion:input_type -> google.protobuf.Empty
+	66, // 44: netem.Netem.ServerPullImages:input_type -> google.protobuf.Empty
+	66, // 45: netem.Netem.ServerCleanContainers:input_type -> google.protobuf.Empty
+	66, // 46: netem.Netem.ProjectGetMany:input_type -> google.protobuf.Empty
+	41, // 47: netem.Netem.ProjectOpen:input_type -> netem.OpenRequest
+	38, // 48: netem.Netem.ProjectClose:input_type -> netem.ProjectRequest
+	38, // 49: netem.Netem.ProjectSave:input_type -> netem.ProjectRequest
+	38, // 50: netem.Netem.ProjectGetNodeConfigs:input_type -> netem.ProjectRequest
+	38, // 51: netem.Netem.ProjectGetStatus:input_type -> netem.ProjectRequest
+	38, // 52: netem.Netem.ProjectGetStats:input_type -> netem.ProjectRequest
+	38, // 53: netem.Netem.ProjectGetAddressing:input_type -> netem.ProjectRequest
+	38, // 54: netem.Netem.ProjectEvents:input_type -> netem.ProjectRequest
+	38, // 55: netem.Netem.ProjectGetSnapshots:input_type -> netem.ProjectRequest
+	34, // 56: netem.Netem.ProjectPruneSnapshots:input_type -> netem.NodeRequest
+	38, // 57: netem.Netem.ReadNetworkFile:input_type -> netem.ProjectRequest
+	40, // 58: netem.Netem.WriteNetworkFile:input_type -> netem.WNetworkRequest
+	38, // 59: netem.Netem.TopologyCheck:input_type -> netem.ProjectRequest
+	38, // 60: netem.Netem.TopologyReload:input_type -> netem.ProjectRequest
+	38, // 61: netem.Netem.TopologyRun:input_type -> netem.ProjectRequest
+	38, // 62: netem.Netem.TopologyStartAll:input_type -> netem.ProjectRequest
+	38, // 63: netem.Netem.TopologyStopAll:input_type -> netem.ProjectRequest
+	39, // 64: netem.Netem.TopologyExport:input_type -> netem.TopologyExportRequest
+	34, // 65: netem.Netem.NodeReadConfigFiles:input_type -> netem.NodeRequest
+	34, // 66: netem.Netem.NodeStart:input_type -> netem.NodeRequest
+	34, // 67: netem.Netem.NodeStop:input_type -> netem.NodeRequest
+	34, // 68: netem.Netem.NodeRestart:input_type -> netem.NodeRequest
+	34, // 69: netem.Netem.NodePause:input_type -> netem.NodeRequest
+	34, // 70: netem.Netem.NodeResume:input_type -> netem.NodeRequest
+	32, // 71: netem.Netem.NodeSetIfState:input_type -> netem.NodeIfStateRequest
+	33, // 72: netem.Netem.NodeCapture:input_type -> netem.NodeInterfaceRequest
+	20, // 73: netem.Netem.NodeCopyFrom:input_type -> netem.CopyMsg
+	20, // 74: netem.Netem.NodeCopyTo:input_type -> netem.CopyMsg
+	37, // 75: netem.Netem.NodeGetConsoleCmd:input_type -> netem.ConsoleCmdRequest
+	16, // 76: netem.Netem.NodeExec:input_type -> netem.ExecCltMsg
+	35, // 77: netem.Netem.NodeLogs:input_type -> netem.NodeLogsRequest
+	36, // 78: netem.Netem.NodePortForward:input_type -> netem.PortForwardRequest
+	18, // 79: netem.Netem.NodeDial:input_type -> netem.DialCltMsg
+	29, // 80: netem.Netem.LinkUpdate:input_type -> netem.LinkRequest
+	29, // 81: netem.Netem.LinkAdd:input_type -> netem.LinkRequest
+	29, // 82: netem.Netem.LinkDel:input_type -> netem.LinkRequest
+	30, // 83: netem.Netem.LinkRemoteConnect:input_type -> netem.RemoteLinkRequest
+	30, // 84: netem.Netem.LinkRemoteDisconnect:input_type -> netem.RemoteLinkRequest
+	45, // 85: netem.Netem.ServerGetVersion:output_type -> netem.VersionResponse
+	21, // 86: netem.Netem.ServerPullImages:output_type -> netem.PullSrvMsg
+	43, // 87: netem.Netem.ServerCleanContainers:output_type -> netem.AckResponse
+	51, // 88: netem.Netem.ProjectGetMany:output_type -> netem.PrjListResponse
+	52, // 89: netem.Netem.ProjectOpen:output_type -> netem.PrjOpenResponse
+	27, // 90: netem.Netem.ProjectClose:output_type -> netem.ProjectCloseMsg
+	26, // 91: netem.Netem.ProjectSave:output_type -> netem.ProjectSaveMsg
+	44, // 92: netem.Netem.ProjectGetNodeConfigs:output_type -> netem.FileResponse
+	47, // 93: netem.Netem.ProjectGetStatus:output_type -> netem.StatusResponse
+	47, // 94: netem.Netem.ProjectGetStats:output_type -> netem.StatusResponse
+	49, // 95: netem.Netem.ProjectGetAddressing:output_type -> netem.AddressingResponse
+	24, // 96: netem.Netem.ProjectEvents:output_type -> netem.NodeEventMsg
+	48, // 97: netem.Netem.ProjectGetSnapshots:output_type -> netem.SnapshotsResponse
+	43, // 98: netem.Netem.ProjectPruneSnapshots:output_type -> netem.AckResponse
+	44, // 99: netem.Netem.ReadNetworkFile:output_type -> netem.FileResponse
+	43, // 100: netem.Netem.WriteNetworkFile:output_type -> netem.AckResponse
+	43, // 101: netem.Netem.TopologyCheck:output_type -> netem.AckResponse
+	25, // 102: netem.Netem.TopologyReload:output_type -> netem.TopologyRunMsg
+	25, // 103: netem.Netem.TopologyRun:output_type -> netem.TopologyRunMsg
+	43, // 104: netem.Netem.TopologyStartAll:output_type -> netem.AckResponse
+	43, // 105: netem.Netem.TopologyStopAll:output_type -> netem.AckResponse
+	44, // 106: netem.Netem.TopologyExport:output_type -> netem.FileResponse
+	50, // 107: netem.Netem.NodeReadConfigFiles:output_type -> netem.ConfigFilesResponse
+	43, // 108: netem.Netem.NodeStart:output_type -> netem.AckResponse
+	43, // 109: netem.Netem.NodeStop:output_type -> netem.AckResponse
+	43, // 110: netem.Netem.NodeRestart:output_type -> netem.AckResponse
+	43, // 111: netem.Netem.NodePause:output_type -> netem.AckResponse
+	43, // 112: netem.Netem.NodeResume:output_type -> netem.AckResponse
+	43, // 113: netem.Netem.NodeSetIfState:output_type -> netem.AckResponse
+	22, // 114: netem.Netem.NodeCapture:output_type -> netem.CaptureSrvMsg
+	20, // 115: netem.Netem.NodeCopyFrom:output_type -> netem.CopyMsg
+	43, // 116: netem.Netem.NodeCopyTo:output_type -> netem.AckResponse
+	46, // 117: netem.Netem.NodeGetConsoleCmd:output_type -> netem.ConsoleCmdResponse
+	17, // 118: netem.Netem.NodeExec:output_type -> netem.ExecSrvMsg
+	23, // 119: netem.Netem.NodeLogs:output_type -> netem.LogsSrvMsg
+	43, // 120: netem.Netem.NodePortForward:output_type -> netem.AckResponse
+	19, // 121: netem.Netem.NodeDial:output_type -> netem.DialSrvMsg
+	43, // 122: netem.Netem.LinkUpdate:output_type -> netem.AckResponse
+	43, // 123: netem.Netem.LinkAdd:output_type -> netem.AckResponse
+	43, // 124: netem.Netem.LinkDel:output_type -> netem.AckResponse
+	31, // 125: netem.Netem.LinkRemoteConnect:output_type -> netem.RemoteLinkResponse
+	43, // 126: netem.Netem.LinkRemoteDisconnect:output_type -> netem.AckResponse
+	85, // [85:127] is the sub-list for method output_type
+	43, // [43:85] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_netem_proto_init() }
@@ -3116,7 +4896,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyMsg); i {
+			switch v := v.(*DialCltMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3128,7 +4908,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullSrvMsg); i {
+			switch v := v.(*DialSrvMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3140,7 +4920,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CaptureSrvMsg); i {
+			switch v := v.(*CopyMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3152,7 +4932,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopologyRunMsg); i {
+			switch v := v.(*PullSrvMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3164,7 +4944,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectSaveMsg); i {
+			switch v := v.(*CaptureSrvMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,7 +4956,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectCloseMsg); i {
+			switch v := v.(*LogsSrvMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,7 +4968,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkConfig); i {
+			switch v := v.(*NodeEventMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,7 +4980,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkRequest); i {
+			switch v := v.(*TopologyRunMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,7 +4992,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeIfStateRequest); i {
+			switch v := v.(*ProjectSaveMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,7 +5004,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInterfaceRequest); i {
+			switch v := v.(*ProjectCloseMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,7 +5016,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRequest); i {
+			switch v := v.(*LinkConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,7 +5028,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsoleCmdRequest); i {
+			switch v := v.(*LinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,7 +5040,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectRequest); i {
+			switch v := v.(*RemoteLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,7 +5052,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WNetworkRequest); i {
+			switch v := v.(*RemoteLinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,7 +5064,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenRequest); i {
+			switch v := v.(*NodeIfStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,7 +5076,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*NodeInterfaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,7 +5088,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckResponse); i {
+			switch v := v.(*NodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,7 +5100,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileResponse); i {
+			switch v := v.(*NodeLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,7 +5112,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+			switch v := v.(*PortForwardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,7 +5124,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsoleCmdResponse); i {
+			switch v := v.(*ConsoleCmdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,7 +5136,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+			switch v := v.(*ProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,7 +5148,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigFilesResponse); i {
+			switch v := v.(*TopologyExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3380,7 +5160,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrjListResponse); i {
+			switch v := v.(*WNetworkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3392,7 +5172,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrjOpenResponse); i {
+			switch v := v.(*OpenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3404,7 +5184,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopologyRunMsg_NodeMessages); i {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3416,7 +5196,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkConfig_QoSConfig); i {
+			switch v := v.(*AckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3428,7 +5208,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse_IfStatus); i {
+			switch v := v.(*FileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3440,7 +5220,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse_NodeStatus); i {
+			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3452,7 +5232,7 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigFilesResponse_ConfigFile); i {
+			switch v := v.(*ConsoleCmdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3464,6 +5244,210 @@ func file_internal_proto_netem_proto_init() {
 			}
 		}
 		file_internal_proto_netem_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrjListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrjOpenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyRunMsg_NodeMessages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkConfig_QoSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_IfStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_NodeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_NodeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_ProjectLinkStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse_PortForwardStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotsResponse_Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressingResponse_Assignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigFilesResponse_ConfigFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_netem_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrjListResponse_Info); i {
 			case 0:
 				return &v.state
@@ -3481,8 +5465,8 @@ func file_internal_proto_netem_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_proto_netem_proto_rawDesc,
-			NumEnums:      11,
-			NumMessages:   32,
+			NumEnums:      16,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,11 @@ const (
 	Netem_ProjectSave_FullMethodName           = "/netem.Netem/ProjectSave"
 	Netem_ProjectGetNodeConfigs_FullMethodName = "/netem.Netem/ProjectGetNodeConfigs"
 	Netem_ProjectGetStatus_FullMethodName      = "/netem.Netem/ProjectGetStatus"
+	Netem_ProjectGetStats_FullMethodName       = "/netem.Netem/ProjectGetStats"
+	Netem_ProjectGetAddressing_FullMethodName  = "/netem.Netem/ProjectGetAddressing"
+	Netem_ProjectEvents_FullMethodName         = "/netem.Netem/ProjectEvents"
+	Netem_ProjectGetSnapshots_FullMethodName   = "/netem.Netem/ProjectGetSnapshots"
+	Netem_ProjectPruneSnapshots_FullMethodName = "/netem.Netem/ProjectPruneSnapshots"
 	Netem_ReadNetworkFile_FullMethodName       = "/netem.Netem/ReadNetworkFile"
 	Netem_WriteNetworkFile_FullMethodName      = "/netem.Netem/WriteNetworkFile"
 	Netem_TopologyCheck_FullMethodName         = "/netem.Netem/TopologyCheck"
@@ -36,19 +41,27 @@ const (
 	Netem_TopologyRun_FullMethodName           = "/netem.Netem/TopologyRun"
 	Netem_TopologyStartAll_FullMethodName      = "/netem.Netem/TopologyStartAll"
 	Netem_TopologyStopAll_FullMethodName       = "/netem.Netem/TopologyStopAll"
+	Netem_TopologyExport_FullMethodName        = "/netem.Netem/TopologyExport"
 	Netem_NodeReadConfigFiles_FullMethodName   = "/netem.Netem/NodeReadConfigFiles"
 	Netem_NodeStart_FullMethodName             = "/netem.Netem/NodeStart"
 	Netem_NodeStop_FullMethodName              = "/netem.Netem/NodeStop"
 	Netem_NodeRestart_FullMethodName           = "/netem.Netem/NodeRestart"
+	Netem_NodePause_FullMethodName             = "/netem.Netem/NodePause"
+	Netem_NodeResume_FullMethodName            = "/netem.Netem/NodeResume"
 	Netem_NodeSetIfState_FullMethodName        = "/netem.Netem/NodeSetIfState"
 	Netem_NodeCapture_FullMethodName           = "/netem.Netem/NodeCapture"
 	Netem_NodeCopyFrom_FullMethodName          = "/netem.Netem/NodeCopyFrom"
 	Netem_NodeCopyTo_FullMethodName            = "/netem.Netem/NodeCopyTo"
 	Netem_NodeGetConsoleCmd_FullMethodName     = "/netem.Netem/NodeGetConsoleCmd"
 	Netem_NodeExec_FullMethodName              = "/netem.Netem/NodeExec"
+	Netem_NodeLogs_FullMethodName              = "/netem.Netem/NodeLogs"
+	Netem_NodePortForward_FullMethodName       = "/netem.Netem/NodePortForward"
+	Netem_NodeDial_FullMethodName              = "/netem.Netem/NodeDial"
 	Netem_LinkUpdate_FullMethodName            = "/netem.Netem/LinkUpdate"
 	Netem_LinkAdd_FullMethodName               = "/netem.Netem/LinkAdd"
 	Netem_LinkDel_FullMethodName               = "/netem.Netem/LinkDel"
+	Netem_LinkRemoteConnect_FullMethodName     = "/netem.Netem/LinkRemoteConnect"
+	Netem_LinkRemoteDisconnect_FullMethodName  = "/netem.Netem/LinkRemoteDisconnect"
 )
 
 // NetemClient is the client API for Netem service.
@@ -66,6 +79,12 @@ type NetemClient interface {
 	ProjectSave(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_ProjectSaveClient, error)
 	ProjectGetNodeConfigs(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*FileResponse, error)
 	ProjectGetStatus(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// status with the resource usage of the docker nodes
+	ProjectGetStats(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ProjectGetAddressing(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*AddressingResponse, error)
+	ProjectEvents(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_ProjectEventsClient, error)
+	ProjectGetSnapshots(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*SnapshotsResponse, error)
+	ProjectPruneSnapshots(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	// Read/Write network topology
 	ReadNetworkFile(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*FileResponse, error)
 	WriteNetworkFile(ctx context.Context, in *WNetworkRequest, opts ...grpc.CallOption) (*AckResponse, error)
@@ -75,21 +94,30 @@ type NetemClient interface {
 	TopologyRun(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_TopologyRunClient, error)
 	TopologyStartAll(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	TopologyStopAll(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	TopologyExport(ctx context.Context, in *TopologyExportRequest, opts ...grpc.CallOption) (*FileResponse, error)
 	// Node actions
 	NodeReadConfigFiles(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*ConfigFilesResponse, error)
 	NodeStart(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	NodeStop(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	NodeRestart(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	NodePause(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	NodeResume(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	NodeSetIfState(ctx context.Context, in *NodeIfStateRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	NodeCapture(ctx context.Context, in *NodeInterfaceRequest, opts ...grpc.CallOption) (Netem_NodeCaptureClient, error)
 	NodeCopyFrom(ctx context.Context, in *CopyMsg, opts ...grpc.CallOption) (Netem_NodeCopyFromClient, error)
 	NodeCopyTo(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeCopyToClient, error)
 	NodeGetConsoleCmd(ctx context.Context, in *ConsoleCmdRequest, opts ...grpc.CallOption) (*ConsoleCmdResponse, error)
 	NodeExec(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeExecClient, error)
+	NodeLogs(ctx context.Context, in *NodeLogsRequest, opts ...grpc.CallOption) (Netem_NodeLogsClient, error)
+	NodePortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	NodeDial(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeDialClient, error)
 	// Link actions
 	LinkUpdate(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	LinkAdd(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*AckResponse, error)
 	LinkDel(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	// Remote links, called by other gonetem servers
+	LinkRemoteConnect(ctx context.Context, in *RemoteLinkRequest, opts ...grpc.CallOption) (*RemoteLinkResponse, error)
+	LinkRemoteDisconnect(ctx context.Context, in *RemoteLinkRequest, opts ...grpc.CallOption) (*AckResponse, error)
 }
 
 type netemClient struct {
@@ -250,6 +278,74 @@ func (c *netemClient) ProjectGetStatus(ctx context.Context, in *ProjectRequest,
 	return out, nil
 }
 
+func (c *netemClient) ProjectGetStats(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, Netem_ProjectGetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) ProjectGetAddressing(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*AddressingResponse, error) {
+	out := new(AddressingResponse)
+	err := c.cc.Invoke(ctx, Netem_ProjectGetAddressing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) ProjectEvents(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_ProjectEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[3], Netem_ProjectEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &netemProjectEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Netem_ProjectEventsClient interface {
+	Recv() (*NodeEventMsg, error)
+	grpc.ClientStream
+}
+
+type netemProjectEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *netemProjectEventsClient) Recv() (*NodeEventMsg, error) {
+	m := new(NodeEventMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *netemClient) ProjectGetSnapshots(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*SnapshotsResponse, error) {
+	out := new(SnapshotsResponse)
+	err := c.cc.Invoke(ctx, Netem_ProjectGetSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) ProjectPruneSnapshots(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, Netem_ProjectPruneSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netemClient) ReadNetworkFile(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*FileResponse, error) {
 	out := new(FileResponse)
 	err := c.cc.Invoke(ctx, Netem_ReadNetworkFile_FullMethodName, in, out, opts...)
@@ -278,7 +374,7 @@ func (c *netemClient) TopologyCheck(ctx context.Context, in *ProjectRequest, opt
 }
 
 func (c *netemClient) TopologyReload(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_TopologyReloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[3], Netem_TopologyReload_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[4], Netem_TopologyReload_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -310,7 +406,7 @@ func (x *netemTopologyReloadClient) Recv() (*TopologyRunMsg, error) {
 }
 
 func (c *netemClient) TopologyRun(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (Netem_TopologyRunClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[4], Netem_TopologyRun_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[5], Netem_TopologyRun_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -359,6 +455,15 @@ func (c *netemClient) TopologyStopAll(ctx context.Context, in *ProjectRequest, o
 	return out, nil
 }
 
+func (c *netemClient) TopologyExport(ctx context.Context, in *TopologyExportRequest, opts ...grpc.CallOption) (*FileResponse, error) {
+	out := new(FileResponse)
+	err := c.cc.Invoke(ctx, Netem_TopologyExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netemClient) NodeReadConfigFiles(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*ConfigFilesResponse, error) {
 	out := new(ConfigFilesResponse)
 	err := c.cc.Invoke(ctx, Netem_NodeReadConfigFiles_FullMethodName, in, out, opts...)
@@ -395,6 +500,24 @@ func (c *netemClient) NodeRestart(ctx context.Context, in *NodeRequest, opts ...
 	return out, nil
 }
 
+func (c *netemClient) NodePause(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, Netem_NodePause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) NodeResume(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, Netem_NodeResume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netemClient) NodeSetIfState(ctx context.Context, in *NodeIfStateRequest, opts ...grpc.CallOption) (*AckResponse, error) {
 	out := new(AckResponse)
 	err := c.cc.Invoke(ctx, Netem_NodeSetIfState_FullMethodName, in, out, opts...)
@@ -405,7 +528,7 @@ func (c *netemClient) NodeSetIfState(ctx context.Context, in *NodeIfStateRequest
 }
 
 func (c *netemClient) NodeCapture(ctx context.Context, in *NodeInterfaceRequest, opts ...grpc.CallOption) (Netem_NodeCaptureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[5], Netem_NodeCapture_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[6], Netem_NodeCapture_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +560,7 @@ func (x *netemNodeCaptureClient) Recv() (*CaptureSrvMsg, error) {
 }
 
 func (c *netemClient) NodeCopyFrom(ctx context.Context, in *CopyMsg, opts ...grpc.CallOption) (Netem_NodeCopyFromClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[6], Netem_NodeCopyFrom_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[7], Netem_NodeCopyFrom_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -469,7 +592,7 @@ func (x *netemNodeCopyFromClient) Recv() (*CopyMsg, error) {
 }
 
 func (c *netemClient) NodeCopyTo(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeCopyToClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[7], Netem_NodeCopyTo_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[8], Netem_NodeCopyTo_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -512,7 +635,7 @@ func (c *netemClient) NodeGetConsoleCmd(ctx context.Context, in *ConsoleCmdReque
 }
 
 func (c *netemClient) NodeExec(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeExecClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[8], Netem_NodeExec_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[9], Netem_NodeExec_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -542,6 +665,78 @@ func (x *netemNodeExecClient) Recv() (*ExecSrvMsg, error) {
 	return m, nil
 }
 
+func (c *netemClient) NodeLogs(ctx context.Context, in *NodeLogsRequest, opts ...grpc.CallOption) (Netem_NodeLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[10], Netem_NodeLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &netemNodeLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Netem_NodeLogsClient interface {
+	Recv() (*LogsSrvMsg, error)
+	grpc.ClientStream
+}
+
+type netemNodeLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *netemNodeLogsClient) Recv() (*LogsSrvMsg, error) {
+	m := new(LogsSrvMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *netemClient) NodePortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, Netem_NodePortForward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) NodeDial(ctx context.Context, opts ...grpc.CallOption) (Netem_NodeDialClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Netem_ServiceDesc.Streams[11], Netem_NodeDial_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &netemNodeDialClient{stream}
+	return x, nil
+}
+
+type Netem_NodeDialClient interface {
+	Send(*DialCltMsg) error
+	Recv() (*DialSrvMsg, error)
+	grpc.ClientStream
+}
+
+type netemNodeDialClient struct {
+	grpc.ClientStream
+}
+
+func (x *netemNodeDialClient) Send(m *DialCltMsg) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *netemNodeDialClient) Recv() (*DialSrvMsg, error) {
+	m := new(DialSrvMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *netemClient) LinkUpdate(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*AckResponse, error) {
 	out := new(AckResponse)
 	err := c.cc.Invoke(ctx, Netem_LinkUpdate_FullMethodName, in, out, opts...)
@@ -569,6 +764,24 @@ func (c *netemClient) LinkDel(ctx context.Context, in *LinkRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *netemClient) LinkRemoteConnect(ctx context.Context, in *RemoteLinkRequest, opts ...grpc.CallOption) (*RemoteLinkResponse, error) {
+	out := new(RemoteLinkResponse)
+	err := c.cc.Invoke(ctx, Netem_LinkRemoteConnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netemClient) LinkRemoteDisconnect(ctx context.Context, in *RemoteLinkRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, Netem_LinkRemoteDisconnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetemServer is the server API for Netem service.
 // All implementations must embed UnimplementedNetemServer
 // for forward compatibility
@@ -584,6 +797,12 @@ type NetemServer interface {
 	ProjectSave(*ProjectRequest, Netem_ProjectSaveServer) error
 	ProjectGetNodeConfigs(context.Context, *ProjectRequest) (*FileResponse, error)
 	ProjectGetStatus(context.Context, *ProjectRequest) (*StatusResponse, error)
+	// status with the resource usage of the docker nodes
+	ProjectGetStats(context.Context, *ProjectRequest) (*StatusResponse, error)
+	ProjectGetAddressing(context.Context, *ProjectRequest) (*AddressingResponse, error)
+	ProjectEvents(*ProjectRequest, Netem_ProjectEventsServer) error
+	ProjectGetSnapshots(context.Context, *ProjectRequest) (*SnapshotsResponse, error)
+	ProjectPruneSnapshots(context.Context, *NodeRequest) (*AckResponse, error)
 	// Read/Write network topology
 	ReadNetworkFile(context.Context, *ProjectRequest) (*FileResponse, error)
 	WriteNetworkFile(context.Context, *WNetworkRequest) (*AckResponse, error)
@@ -593,21 +812,30 @@ type NetemServer interface {
 	TopologyRun(*ProjectRequest, Netem_TopologyRunServer) error
 	TopologyStartAll(context.Context, *ProjectRequest) (*AckResponse, error)
 	TopologyStopAll(context.Context, *ProjectRequest) (*AckResponse, error)
+	TopologyExport(context.Context, *TopologyExportRequest) (*FileResponse, error)
 	// Node actions
 	NodeReadConfigFiles(context.Context, *NodeRequest) (*ConfigFilesResponse, error)
 	NodeStart(context.Context, *NodeRequest) (*AckResponse, error)
 	NodeStop(context.Context, *NodeRequest) (*AckResponse, error)
 	NodeRestart(context.Context, *NodeRequest) (*AckResponse, error)
+	NodePause(context.Context, *NodeRequest) (*AckResponse, error)
+	NodeResume(context.Context, *NodeRequest) (*AckResponse, error)
 	NodeSetIfState(context.Context, *NodeIfStateRequest) (*AckResponse, error)
 	NodeCapture(*NodeInterfaceRequest, Netem_NodeCaptureServer) error
 	NodeCopyFrom(*CopyMsg, Netem_NodeCopyFromServer) error
 	NodeCopyTo(Netem_NodeCopyToServer) error
 	NodeGetConsoleCmd(context.Context, *ConsoleCmdRequest) (*ConsoleCmdResponse, error)
 	NodeExec(Netem_NodeExecServer) error
+	NodeLogs(*NodeLogsRequest, Netem_NodeLogsServer) error
+	NodePortForward(context.Context, *PortForwardRequest) (*AckResponse, error)
+	NodeDial(Netem_NodeDialServer) error
 	// Link actions
 	LinkUpdate(context.Context, *LinkRequest) (*AckResponse, error)
 	LinkAdd(context.Context, *LinkRequest) (*AckResponse, error)
 	LinkDel(context.Context, *LinkRequest) (*AckResponse, error)
+	// Remote links, called by other gonetem servers
+	LinkRemoteConnect(context.Context, *RemoteLinkRequest) (*RemoteLinkResponse, error)
+	LinkRemoteDisconnect(context.Context, *RemoteLinkRequest) (*AckResponse, error)
 	mustEmbedUnimplementedNetemServer()
 }
 
@@ -642,6 +870,21 @@ func (UnimplementedNetemServer) ProjectGetNodeConfigs(context.Context, *ProjectR
 func (UnimplementedNetemServer) ProjectGetStatus(context.Context, *ProjectRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProjectGetStatus not implemented")
 }
+func (UnimplementedNetemServer) ProjectGetStats(context.Context, *ProjectRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGetStats not implemented")
+}
+func (UnimplementedNetemServer) ProjectGetAddressing(context.Context, *ProjectRequest) (*AddressingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGetAddressing not implemented")
+}
+func (UnimplementedNetemServer) ProjectEvents(*ProjectRequest, Netem_ProjectEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProjectEvents not implemented")
+}
+func (UnimplementedNetemServer) ProjectGetSnapshots(context.Context, *ProjectRequest) (*SnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGetSnapshots not implemented")
+}
+func (UnimplementedNetemServer) ProjectPruneSnapshots(context.Context, *NodeRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectPruneSnapshots not implemented")
+}
 func (UnimplementedNetemServer) ReadNetworkFile(context.Context, *ProjectRequest) (*FileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadNetworkFile not implemented")
 }
@@ -663,6 +906,9 @@ func (UnimplementedNetemServer) TopologyStartAll(context.Context, *ProjectReques
 func (UnimplementedNetemServer) TopologyStopAll(context.Context, *ProjectRequest) (*AckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TopologyStopAll not implemented")
 }
+func (UnimplementedNetemServer) TopologyExport(context.Context, *TopologyExportRequest) (*FileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopologyExport not implemented")
+}
 func (UnimplementedNetemServer) NodeReadConfigFiles(context.Context, *NodeRequest) (*ConfigFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeReadConfigFiles not implemented")
 }
@@ -675,6 +921,12 @@ func (UnimplementedNetemServer) NodeStop(context.Context, *NodeRequest) (*AckRes
 func (UnimplementedNetemServer) NodeRestart(context.Context, *NodeRequest) (*AckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeRestart not implemented")
 }
+func (UnimplementedNetemServer) NodePause(context.Context, *NodeRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodePause not implemented")
+}
+func (UnimplementedNetemServer) NodeResume(context.Context, *NodeRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeResume not implemented")
+}
 func (UnimplementedNetemServer) NodeSetIfState(context.Context, *NodeIfStateRequest) (*AckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeSetIfState not implemented")
 }
@@ -693,6 +945,15 @@ func (UnimplementedNetemServer) NodeGetConsoleCmd(context.Context, *ConsoleCmdRe
 func (UnimplementedNetemServer) NodeExec(Netem_NodeExecServer) error {
 	return status.Errorf(codes.Unimplemented, "method NodeExec not implemented")
 }
+func (UnimplementedNetemServer) NodeLogs(*NodeLogsRequest, Netem_NodeLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method NodeLogs not implemented")
+}
+func (UnimplementedNetemServer) NodePortForward(context.Context, *PortForwardRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodePortForward not implemented")
+}
+func (UnimplementedNetemServer) NodeDial(Netem_NodeDialServer) error {
+	return status.Errorf(codes.Unimplemented, "method NodeDial not implemented")
+}
 func (UnimplementedNetemServer) LinkUpdate(context.Context, *LinkRequest) (*AckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkUpdate not implemented")
 }
@@ -702,6 +963,12 @@ func (UnimplementedNetemServer) LinkAdd(context.Context, *LinkRequest) (*AckResp
 func (UnimplementedNetemServer) LinkDel(context.Context, *LinkRequest) (*AckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkDel not implemented")
 }
+func (UnimplementedNetemServer) LinkRemoteConnect(context.Context, *RemoteLinkRequest) (*RemoteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkRemoteConnect not implemented")
+}
+func (UnimplementedNetemServer) LinkRemoteDisconnect(context.Context, *RemoteLinkRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkRemoteDisconnect not implemented")
+}
 func (UnimplementedNetemServer) mustEmbedUnimplementedNetemServer() {}
 
 // UnsafeNetemServer may be embedded to opt out of forward compatibility for this service.
@@ -886,6 +1153,99 @@ func _Netem_ProjectGetStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Netem_ProjectGetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).ProjectGetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_ProjectGetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).ProjectGetStats(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_ProjectGetAddressing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).ProjectGetAddressing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_ProjectGetAddressing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).ProjectGetAddressing(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_ProjectEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NetemServer).ProjectEvents(m, &netemProjectEventsServer{stream})
+}
+
+type Netem_ProjectEventsServer interface {
+	Send(*NodeEventMsg) error
+	grpc.ServerStream
+}
+
+type netemProjectEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *netemProjectEventsServer) Send(m *NodeEventMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Netem_ProjectGetSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).ProjectGetSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_ProjectGetSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).ProjectGetSnapshots(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_ProjectPruneSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).ProjectPruneSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_ProjectPruneSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).ProjectPruneSnapshots(ctx, req.(*NodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Netem_ReadNetworkFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectRequest)
 	if err := dec(in); err != nil {
@@ -1018,6 +1378,24 @@ func _Netem_TopologyStopAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Netem_TopologyExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopologyExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).TopologyExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_TopologyExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).TopologyExport(ctx, req.(*TopologyExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Netem_NodeReadConfigFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeRequest)
 	if err := dec(in); err != nil {
@@ -1090,6 +1468,42 @@ func _Netem_NodeRestart_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Netem_NodePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).NodePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_NodePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).NodePause(ctx, req.(*NodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_NodeResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).NodeResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_NodeResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).NodeResume(ctx, req.(*NodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Netem_NodeSetIfState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeIfStateRequest)
 	if err := dec(in); err != nil {
@@ -1220,6 +1634,71 @@ func (x *netemNodeExecServer) Recv() (*ExecCltMsg, error) {
 	return m, nil
 }
 
+func _Netem_NodeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NodeLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NetemServer).NodeLogs(m, &netemNodeLogsServer{stream})
+}
+
+type Netem_NodeLogsServer interface {
+	Send(*LogsSrvMsg) error
+	grpc.ServerStream
+}
+
+type netemNodeLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *netemNodeLogsServer) Send(m *LogsSrvMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Netem_NodePortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).NodePortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_NodePortForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).NodePortForward(ctx, req.(*PortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_NodeDial_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NetemServer).NodeDial(&netemNodeDialServer{stream})
+}
+
+type Netem_NodeDialServer interface {
+	Send(*DialSrvMsg) error
+	Recv() (*DialCltMsg, error)
+	grpc.ServerStream
+}
+
+type netemNodeDialServer struct {
+	grpc.ServerStream
+}
+
+func (x *netemNodeDialServer) Send(m *DialSrvMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *netemNodeDialServer) Recv() (*DialCltMsg, error) {
+	m := new(DialCltMsg)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Netem_LinkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LinkRequest)
 	if err := dec(in); err != nil {
@@ -1274,6 +1753,42 @@ func _Netem_LinkDel_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Netem_LinkRemoteConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).LinkRemoteConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_LinkRemoteConnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).LinkRemoteConnect(ctx, req.(*RemoteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Netem_LinkRemoteDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetemServer).LinkRemoteDisconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Netem_LinkRemoteDisconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetemServer).LinkRemoteDisconnect(ctx, req.(*RemoteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Netem_ServiceDesc is the grpc.ServiceDesc for Netem service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1305,6 +1820,22 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectGetStatus",
 			Handler:    _Netem_ProjectGetStatus_Handler,
 		},
+		{
+			MethodName: "ProjectGetStats",
+			Handler:    _Netem_ProjectGetStats_Handler,
+		},
+		{
+			MethodName: "ProjectGetAddressing",
+			Handler:    _Netem_ProjectGetAddressing_Handler,
+		},
+		{
+			MethodName: "ProjectGetSnapshots",
+			Handler:    _Netem_ProjectGetSnapshots_Handler,
+		},
+		{
+			MethodName: "ProjectPruneSnapshots",
+			Handler:    _Netem_ProjectPruneSnapshots_Handler,
+		},
 		{
 			MethodName: "ReadNetworkFile",
 			Handler:    _Netem_ReadNetworkFile_Handler,
@@ -1325,6 +1856,10 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TopologyStopAll",
 			Handler:    _Netem_TopologyStopAll_Handler,
 		},
+		{
+			MethodName: "TopologyExport",
+			Handler:    _Netem_TopologyExport_Handler,
+		},
 		{
 			MethodName: "NodeReadConfigFiles",
 			Handler:    _Netem_NodeReadConfigFiles_Handler,
@@ -1341,6 +1876,14 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NodeRestart",
 			Handler:    _Netem_NodeRestart_Handler,
 		},
+		{
+			MethodName: "NodePause",
+			Handler:    _Netem_NodePause_Handler,
+		},
+		{
+			MethodName: "NodeResume",
+			Handler:    _Netem_NodeResume_Handler,
+		},
 		{
 			MethodName: "NodeSetIfState",
 			Handler:    _Netem_NodeSetIfState_Handler,
@@ -1349,6 +1892,10 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NodeGetConsoleCmd",
 			Handler:    _Netem_NodeGetConsoleCmd_Handler,
 		},
+		{
+			MethodName: "NodePortForward",
+			Handler:    _Netem_NodePortForward_Handler,
+		},
 		{
 			MethodName: "LinkUpdate",
 			Handler:    _Netem_LinkUpdate_Handler,
@@ -1361,6 +1908,14 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LinkDel",
 			Handler:    _Netem_LinkDel_Handler,
 		},
+		{
+			MethodName: "LinkRemoteConnect",
+			Handler:    _Netem_LinkRemoteConnect_Handler,
+		},
+		{
+			MethodName: "LinkRemoteDisconnect",
+			Handler:    _Netem_LinkRemoteDisconnect_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1378,6 +1933,11 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Netem_ProjectSave_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProjectEvents",
+			Handler:       _Netem_ProjectEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "TopologyReload",
 			Handler:       _Netem_TopologyReload_Handler,
@@ -1409,6 +1969,17 @@ var Netem_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "NodeLogs",
+			Handler:       _Netem_NodeLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "NodeDial",
+			Handler:       _Netem_NodeDial_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "internal/proto/netem.proto",
 }
@@ -0,0 +1,503 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ipv6SubnetPrefix = 64
+	loopbackIfName   = "lo"
+)
+
+type AddressAssignment struct {
+	Node    string
+	IfName  string
+	Network string
+	IPv4    netip.Prefix
+	IPv6    netip.Prefix
+}
+
+type AddressingPlan struct {
+	Assignments []AddressAssignment
+	nodes       map[string]NodeConfig
+}
+
+// addressPool allocates aligned subnets sequentially from a prefix
+type addressPool struct {
+	prefix netip.Prefix
+	next   *big.Int
+	last   *big.Int
+}
+
+func newAddressPool(prefix netip.Prefix) *addressPool {
+	prefix = prefix.Masked()
+	first := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	size := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+
+	return &addressPool{
+		prefix: prefix,
+		next:   first,
+		last:   new(big.Int).Add(first, size),
+	}
+}
+
+func (p *addressPool) Allocate(bits int) (netip.Prefix, error) {
+	size := new(big.Int).Lsh(big.NewInt(1), uint(p.prefix.Addr().BitLen()-bits))
+
+	// align the start of the subnet on its size
+	start := new(big.Int).Add(p.next, new(big.Int).Sub(size, big.NewInt(1)))
+	start.Div(start, size).Mul(start, size)
+
+	end := new(big.Int).Add(start, size)
+	if bits < p.prefix.Bits() || end.Cmp(p.last) > 0 {
+		return netip.Prefix{}, fmt.Errorf("address pool %s exhausted", p.prefix)
+	}
+	p.next = end
+
+	return netip.PrefixFrom(bigToAddr(start, p.prefix.Addr().Is4()), bits), nil
+}
+
+func bigToAddr(value *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		var buf [4]byte
+		value.FillBytes(buf[:])
+		return netip.AddrFrom4(buf)
+	}
+
+	var buf [16]byte
+	value.FillBytes(buf[:])
+	return netip.AddrFrom16(buf)
+}
+
+// hostAddress returns the n-th address of the subnet with its prefix length
+func hostAddress(subnet netip.Prefix, n int) netip.Prefix {
+	value := new(big.Int).SetBytes(subnet.Addr().AsSlice())
+	value.Add(value, big.NewInt(int64(n)))
+
+	return netip.PrefixFrom(bigToAddr(value, subnet.Addr().Is4()), subnet.Bits())
+}
+
+func isAddressableNode(nConfig NodeConfig) bool {
+	switch nConfig.Type {
+	case "docker.router", "docker.host", "docker.server":
+		return true
+	}
+	return false
+}
+
+type addressingPeer struct {
+	node    string
+	ifIndex int
+}
+
+func parseAddressingPeer(peer string) addressingPeer {
+	split := strings.Split(peer, ".")
+	ifIndex, _ := strconv.Atoi(split[1])
+
+	return addressingPeer{node: split[0], ifIndex: ifIndex}
+}
+
+type addressingNetwork struct {
+	name  string
+	peers []addressingPeer
+}
+
+func (n *addressingNetwork) sortPeers(nodes map[string]NodeConfig) {
+	// routers first, so they get the first addresses of the subnet
+	sort.SliceStable(n.peers, func(i, j int) bool {
+		iRouter := nodes[n.peers[i].node].Type == "docker.router"
+		jRouter := nodes[n.peers[j].node].Type == "docker.router"
+		if iRouter != jRouter {
+			return iRouter
+		}
+		if n.peers[i].node != n.peers[j].node {
+			return n.peers[i].node < n.peers[j].node
+		}
+		return n.peers[i].ifIndex < n.peers[j].ifIndex
+	})
+}
+
+// getSegments returns the networks built by ovs switches, switches
+// connected together belong to the same segment
+func getSegments(topology *NetemTopology) []*addressingNetwork {
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(name string) string {
+		if parent[name] == name {
+			return name
+		}
+		parent[name] = find(parent[name])
+		return parent[name]
+	}
+
+	for name, nConfig := range topology.Nodes {
+		if nConfig.Type == "ovs" {
+			parent[name] = name
+		}
+	}
+
+	for _, lConfig := range topology.Links {
+		if IsRemotePeer(lConfig.Peer2) || IsProjectPeer(lConfig.Peer2) {
+			continue
+		}
+
+		peer1 := parseAddressingPeer(lConfig.Peer1)
+		peer2 := parseAddressingPeer(lConfig.Peer2)
+		_, isSw1 := parent[peer1.node]
+		_, isSw2 := parent[peer2.node]
+		if isSw1 && isSw2 {
+			root1, root2 := find(peer1.node), find(peer2.node)
+			// keep the smallest name as root to get stable segment names
+			if root1 < root2 {
+				parent[root2] = root1
+			} else {
+				parent[root1] = root2
+			}
+		}
+	}
+
+	segments := make(map[string]*addressingNetwork)
+	for _, lConfig := range topology.Links {
+		if IsRemotePeer(lConfig.Peer2) || IsProjectPeer(lConfig.Peer2) {
+			continue
+		}
+
+		peer1 := parseAddressingPeer(lConfig.Peer1)
+		peer2 := parseAddressingPeer(lConfig.Peer2)
+		_, isSw1 := parent[peer1.node]
+		_, isSw2 := parent[peer2.node]
+		if isSw1 == isSw2 {
+			continue
+		}
+
+		swName, peer := peer1.node, peer2
+		if isSw2 {
+			swName, peer = peer2.node, peer1
+		}
+		if !isAddressableNode(topology.Nodes[peer.node]) {
+			continue
+		}
+
+		root := find(swName)
+		if _, found := segments[root]; !found {
+			segments[root] = &addressingNetwork{name: "segment " + root}
+		}
+		segments[root].peers = append(segments[root].peers, peer)
+	}
+
+	roots := make([]string, 0, len(segments))
+	for root := range segments {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+
+	result := make([]*addressingNetwork, len(roots))
+	for idx, root := range roots {
+		result[idx] = segments[root]
+		result[idx].sortPeers(topology.Nodes)
+	}
+	return result
+}
+
+// getP2PNetworks returns the networks built by links between two nodes
+// which are not switches
+func getP2PNetworks(topology *NetemTopology) []*addressingNetwork {
+	var networks []*addressingNetwork
+
+	for _, lConfig := range topology.Links {
+		// the remote end is addressed by the other server or project
+		if IsRemotePeer(lConfig.Peer2) || IsProjectPeer(lConfig.Peer2) {
+			continue
+		}
+
+		peer1 := parseAddressingPeer(lConfig.Peer1)
+		peer2 := parseAddressingPeer(lConfig.Peer2)
+		nConfig1 := topology.Nodes[peer1.node]
+		nConfig2 := topology.Nodes[peer2.node]
+		if nConfig1.Type == "ovs" || nConfig2.Type == "ovs" {
+			continue
+		}
+
+		network := &addressingNetwork{name: lConfig.Peer1 + "-" + lConfig.Peer2}
+		for _, peer := range []addressingPeer{peer1, peer2} {
+			if isAddressableNode(topology.Nodes[peer.node]) {
+				network.peers = append(network.peers, peer)
+			}
+		}
+		if len(network.peers) > 0 {
+			networks = append(networks, network)
+		}
+	}
+
+	return networks
+}
+
+// ComputeAddressingPlan allocates addresses to router loopbacks, point
+// to point links and switch segments, in this order
+func ComputeAddressingPlan(topology *NetemTopology) (*AddressingPlan, error) {
+	plan := &AddressingPlan{nodes: topology.Nodes}
+	config := topology.Addressing
+
+	var pool4, pool6 *addressPool
+	if config.IPv4 != "" {
+		prefix, err := netip.ParsePrefix(config.IPv4)
+		if err != nil || !prefix.Addr().Is4() {
+			return nil, fmt.Errorf("addressing: '%s' is not a valid IPv4 prefix", config.IPv4)
+		}
+		pool4 = newAddressPool(prefix)
+	}
+	if config.IPv6 != "" {
+		prefix, err := netip.ParsePrefix(config.IPv6)
+		if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+			return nil, fmt.Errorf("addressing: '%s' is not a valid IPv6 prefix", config.IPv6)
+		}
+		pool6 = newAddressPool(prefix)
+	}
+	if p2p := config.GetP2PPrefix(); p2p != 30 && p2p != 31 {
+		return nil, fmt.Errorf("addressing: p2pprefix must be 30 or 31")
+	}
+	if seg := config.GetSegmentPrefix(); seg < 8 || seg > 30 {
+		return nil, fmt.Errorf("addressing: segmentprefix must be between 8 and 30")
+	}
+
+	// allocate a subnet for each network then an address for each peer
+	allocate := func(network *addressingNetwork, bits4, bits6 int, first4, first6 int) error {
+		var subnet4, subnet6 netip.Prefix
+		var err error
+
+		if pool4 != nil {
+			if subnet4, err = pool4.Allocate(bits4); err != nil {
+				return fmt.Errorf("addressing: %s: %w", network.name, err)
+			}
+			capacity := 1<<(32-bits4) - first4
+			if bits4 < 31 {
+				capacity-- // broadcast address
+			}
+			if len(network.peers) > capacity {
+				return fmt.Errorf("addressing: %s: subnet %s is too small", network.name, subnet4)
+			}
+		}
+		if pool6 != nil {
+			if subnet6, err = pool6.Allocate(bits6); err != nil {
+				return fmt.Errorf("addressing: %s: %w", network.name, err)
+			}
+		}
+
+		for idx, peer := range network.peers {
+			assignment := AddressAssignment{
+				Node:    peer.node,
+				IfName:  fmt.Sprintf("eth%d", peer.ifIndex),
+				Network: network.name,
+			}
+			if bits4 == 32 {
+				assignment.IfName = loopbackIfName
+			}
+			if subnet4.IsValid() {
+				assignment.IPv4 = hostAddress(subnet4, first4+idx)
+			}
+			if subnet6.IsValid() && topology.Nodes[peer.node].IPv6 {
+				assignment.IPv6 = hostAddress(subnet6, first6+idx)
+			}
+			plan.Assignments = append(plan.Assignments, assignment)
+		}
+
+		return nil
+	}
+
+	// 1 - router loopbacks
+	var routers []string
+	for name, nConfig := range topology.Nodes {
+		if nConfig.Type == "docker.router" {
+			routers = append(routers, name)
+		}
+	}
+	sort.Strings(routers)
+	for _, name := range routers {
+		network := &addressingNetwork{
+			name:  "loopback",
+			peers: []addressingPeer{{node: name}},
+		}
+		if err := allocate(network, 32, 128, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2 - point to point links
+	first4 := 1
+	if config.GetP2PPrefix() == 31 {
+		first4 = 0
+	}
+	for _, network := range getP2PNetworks(topology) {
+		if err := allocate(network, config.GetP2PPrefix(), ipv6SubnetPrefix, first4, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3 - switch segments
+	for _, network := range getSegments(topology) {
+		if err := allocate(network, config.GetSegmentPrefix(), ipv6SubnetPrefix, 1, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// GetNodeAssignments returns addresses assigned to a node, sorted by
+// interface name
+func (p *AddressingPlan) GetNodeAssignments(node string) []AddressAssignment {
+	var assignments []AddressAssignment
+	for _, a := range p.Assignments {
+		if a.Node == node {
+			assignments = append(assignments, a)
+		}
+	}
+
+	sort.SliceStable(assignments, func(i, j int) bool {
+		return assignments[i].IfName < assignments[j].IfName
+	})
+	return assignments
+}
+
+// getGateway returns the first router assignment found in a network
+func (p *AddressingPlan) getGateway(network string) *AddressAssignment {
+	for idx, a := range p.Assignments {
+		if a.Network == network && p.nodes[a.Node].Type == "docker.router" {
+			return &p.Assignments[idx]
+		}
+	}
+	return nil
+}
+
+type netConfAddress struct {
+	Address string `json:"address"`
+	Version int    `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+type netConfRoute struct {
+	Dst     string `json:"dst"`
+	Gateway string `json:"gateway"`
+	Family  int    `json:"family"`
+}
+
+// netConf follows the format of the network-config.py script
+type netConf struct {
+	Interfaces map[string][]netConfAddress `json:"interfaces"`
+	Routes     []netConfRoute              `json:"routes"`
+	Bondings   map[string]interface{}      `json:"bondings"`
+	Vlans      map[string]interface{}      `json:"vlans"`
+}
+
+func (p *AddressingPlan) generateNetConf(node string) ([]byte, error) {
+	conf := netConf{
+		Interfaces: make(map[string][]netConfAddress),
+		Routes:     make([]netConfRoute, 0),
+		Bondings:   make(map[string]interface{}),
+		Vlans:      make(map[string]interface{}),
+	}
+
+	var gw4, gw6 string
+	for _, a := range p.GetNodeAssignments(node) {
+		addresses := make([]netConfAddress, 0)
+		if a.IPv4.IsValid() {
+			addresses = append(addresses, netConfAddress{Address: a.IPv4.String(), Version: 4, Kind: "PERMANENT"})
+		}
+		if a.IPv6.IsValid() {
+			addresses = append(addresses, netConfAddress{Address: a.IPv6.String(), Version: 6, Kind: "PERMANENT"})
+		}
+		conf.Interfaces[a.IfName] = addresses
+
+		// use the first router found as default gateway
+		if gw := p.getGateway(a.Network); gw != nil {
+			if gw4 == "" && a.IPv4.IsValid() && gw.IPv4.IsValid() {
+				gw4 = gw.IPv4.Addr().String()
+			}
+			if gw6 == "" && a.IPv6.IsValid() && gw.IPv6.IsValid() {
+				gw6 = gw.IPv6.Addr().String()
+			}
+		}
+	}
+
+	if gw4 != "" {
+		conf.Routes = append(conf.Routes, netConfRoute{Dst: "default", Gateway: gw4, Family: 2})
+	}
+	if gw6 != "" {
+		conf.Routes = append(conf.Routes, netConfRoute{Dst: "default", Gateway: gw6, Family: 10})
+	}
+
+	return json.MarshalIndent(conf, "", "    ")
+}
+
+func (p *AddressingPlan) generateFrrConf(node string) []byte {
+	var b strings.Builder
+
+	b.WriteString("! -*- frr configuration generated from the gonetem addressing plan -*-\n")
+	b.WriteString("!\n")
+	b.WriteString("service integrated-vtysh-config\n")
+	b.WriteString("!\n")
+	for _, a := range p.GetNodeAssignments(node) {
+		fmt.Fprintf(&b, "interface %s\n", a.IfName)
+		if a.IPv4.IsValid() {
+			fmt.Fprintf(&b, " ip address %s\n", a.IPv4)
+		}
+		if a.IPv6.IsValid() {
+			fmt.Fprintf(&b, " ipv6 address %s\n", a.IPv6)
+		}
+		b.WriteString("exit\n!\n")
+	}
+
+	return []byte(b.String())
+}
+
+// GenerateConfigs writes the configuration files of each addressed node
+// in confPath. Existing files are kept as they contain the configuration
+// saved by the user
+func (p *AddressingPlan) GenerateConfigs(confPath string) error {
+	if _, err := os.Stat(confPath); os.IsNotExist(err) {
+		if err := os.Mkdir(confPath, 0755); err != nil {
+			return fmt.Errorf("unable to create configs dir %s: %w", confPath, err)
+		}
+	}
+
+	for name, nConfig := range p.nodes {
+		if len(p.GetNodeAssignments(name)) == 0 {
+			continue
+		}
+
+		var filename string
+		var data []byte
+		var err error
+
+		switch nConfig.Type {
+		case "docker.router":
+			filename = name + ".frr.conf"
+			data = p.generateFrrConf(name)
+		case "docker.host", "docker.server":
+			filename = name + ".net.conf"
+			if data, err = p.generateNetConf(name); err != nil {
+				return fmt.Errorf("unable to generate net config of node %s: %w", name, err)
+			}
+		default:
+			continue
+		}
+
+		filepath := path.Join(confPath, filename)
+		if _, err := os.Stat(filepath); err == nil {
+			continue
+		}
+		if err := os.WriteFile(filepath, data, 0644); err != nil {
+			return fmt.Errorf("unable to write config file %s: %w", filepath, err)
+		}
+	}
+
+	return nil
+}
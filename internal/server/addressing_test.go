@@ -0,0 +1,141 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+)
+
+func getAddressingTopology(p2pPrefix int) *NetemTopology {
+	return &NetemTopology{
+		Nodes: map[string]NodeConfig{
+			"R1":   {Type: "docker.router", IPv6: true},
+			"R2":   {Type: "docker.router", IPv6: true},
+			"host": {Type: "docker.host", IPv6: false},
+			"sw1":  {Type: "ovs"},
+			"sw2":  {Type: "ovs"},
+		},
+		Links: []LinkConfig{
+			{Peer1: "R1.0", Peer2: "R2.0"},
+			{Peer1: "R2.1", Peer2: "sw1.0"},
+			{Peer1: "sw1.1", Peer2: "sw2.0"},
+			{Peer1: "host.0", Peer2: "sw2.1"},
+		},
+		Addressing: AddressingConfig{
+			IPv4:      "10.0.0.0/16",
+			IPv6:      "2001:db8::/48",
+			P2PPrefix: p2pPrefix,
+		},
+	}
+}
+
+func getAssignment(plan *AddressingPlan, node, ifName string) *AddressAssignment {
+	for idx, a := range plan.Assignments {
+		if a.Node == node && a.IfName == ifName {
+			return &plan.Assignments[idx]
+		}
+	}
+	return nil
+}
+
+func TestAddressingPlan_Compute(t *testing.T) {
+	tests := []struct {
+		desc      string
+		p2pPrefix int
+		node      string
+		ifName    string
+		ipv4      string
+		ipv6      string
+	}{
+		{"Addressing: R1 loopback", 0, "R1", "lo", "10.0.0.0/32", "2001:db8::/128"},
+		{"Addressing: R2 loopback", 0, "R2", "lo", "10.0.0.1/32", "2001:db8::1/128"},
+		{"Addressing: R1 /30 p2p link", 0, "R1", "eth0", "10.0.0.5/30", "2001:db8:0:1::1/64"},
+		{"Addressing: R2 /30 p2p link", 0, "R2", "eth0", "10.0.0.6/30", "2001:db8:0:1::2/64"},
+		{"Addressing: R2 /31 p2p link", 31, "R2", "eth0", "10.0.0.3/31", "2001:db8:0:1::2/64"},
+		{"Addressing: R2 segment", 0, "R2", "eth1", "10.0.1.1/24", "2001:db8:0:2::1/64"},
+		{"Addressing: host segment without ipv6", 0, "host", "eth0", "10.0.1.2/24", "invalid Prefix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			plan, err := ComputeAddressingPlan(getAddressingTopology(tt.p2pPrefix))
+			if err != nil {
+				t.Fatalf("Unable to compute addressing plan: %v", err)
+			}
+
+			a := getAssignment(plan, tt.node, tt.ifName)
+			if a == nil {
+				t.Fatalf("No assignment found for %s/%s", tt.node, tt.ifName)
+			}
+			if a.IPv4.String() != tt.ipv4 || a.IPv6.String() != tt.ipv6 {
+				t.Errorf("Unexpected addresses %s/%s != %s/%s", a.IPv4, a.IPv6, tt.ipv4, tt.ipv6)
+			}
+		})
+	}
+}
+
+func TestAddressingPlan_Errors(t *testing.T) {
+	topology := getAddressingTopology(0)
+	topology.Addressing.IPv4 = "10.0.0.0/28"
+	if _, err := ComputeAddressingPlan(topology); err == nil {
+		t.Errorf("An error is expected when the pool is exhausted")
+	}
+
+	topology = getAddressingTopology(29)
+	if _, err := ComputeAddressingPlan(topology); err == nil {
+		t.Errorf("An error is expected with a wrong p2p prefix")
+	}
+
+	topology = getAddressingTopology(0)
+	topology.Addressing.IPv6 = "10.1.0.0/16"
+	if _, err := ComputeAddressingPlan(topology); err == nil {
+		t.Errorf("An error is expected with an IPv4 prefix in the ipv6 pool")
+	}
+}
+
+func TestAddressingPlan_GenerateConfigs(t *testing.T) {
+	plan, err := ComputeAddressingPlan(getAddressingTopology(0))
+	if err != nil {
+		t.Fatalf("Unable to compute addressing plan: %v", err)
+	}
+
+	dir, err := os.MkdirTemp("/tmp", "ntmtst")
+	if err != nil {
+		t.Fatalf("Unable to create temp folder: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// existing files must be kept
+	r1Conf := path.Join(dir, "R1.frr.conf")
+	if err := os.WriteFile(r1Conf, []byte("saved"), 0644); err != nil {
+		t.Fatalf("Unable to write file: %v", err)
+	}
+
+	if err := plan.GenerateConfigs(dir); err != nil {
+		t.Fatalf("Unable to generate configs: %v", err)
+	}
+
+	if data, _ := os.ReadFile(r1Conf); string(data) != "saved" {
+		t.Errorf("Existing config file of R1 has been overwritten")
+	}
+	if _, err := os.Stat(path.Join(dir, "R2.frr.conf")); err != nil {
+		t.Errorf("Config file of R2 has not been generated: %v", err)
+	}
+
+	data, err := os.ReadFile(path.Join(dir, "host.net.conf"))
+	if err != nil {
+		t.Fatalf("Net config file of host has not been generated: %v", err)
+	}
+
+	var conf netConf
+	if err := json.Unmarshal(data, &conf); err != nil {
+		t.Fatalf("Unable to parse net config of host: %v", err)
+	}
+	if len(conf.Interfaces["eth0"]) != 1 || conf.Interfaces["eth0"][0].Address != "10.0.1.2/24" {
+		t.Errorf("Unexpected eth0 config for host: %v", conf.Interfaces["eth0"])
+	}
+	if len(conf.Routes) != 1 || conf.Routes[0].Gateway != "10.0.1.1" {
+		t.Errorf("Unexpected routes for host: %v", conf.Routes)
+	}
+}
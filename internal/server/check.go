@@ -5,18 +5,23 @@ import (
 	"net"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/mroy31/gonetem/internal/docker"
 	"github.com/mroy31/gonetem/internal/link"
+	"github.com/mroy31/gonetem/internal/options"
 	"gopkg.in/yaml.v3"
 )
 
 var (
-	nameRE     = regexp.MustCompile(`^\w+$`)
-	switchRE   = regexp.MustCompile(`^\w{1,10}$`)
-	nodeTypeRE = regexp.MustCompile(`^docker\.\w+|ovs$`)
-	peerRE     = regexp.MustCompile(`^\w+.[0-9]+$`)
-	volumeRE   = regexp.MustCompile(`^[^\0]+:[^\0]+$`)
+	nameRE        = regexp.MustCompile(`^\w+$`)
+	switchRE      = regexp.MustCompile(`^\w{1,10}$`)
+	nodeTypeRE    = regexp.MustCompile(`^docker\.\w+|ovs$`)
+	peerRE        = regexp.MustCompile(`^\w+.[0-9]+$`)
+	volumeRE      = regexp.MustCompile(`^[^\0]+:[^\0]+$`)
+	cpusetRE      = regexp.MustCompile(`^\d+(-\d+)?(,\d+(-\d+)?)*$`)
+	profileNameRE = regexp.MustCompile(`^[\w-]+$`)
 )
 
 func checkNodeConfig(name string, nConfig NodeConfig, nodes []string) error {
@@ -57,6 +62,48 @@ func checkNodeConfig(name string, nConfig NodeConfig, nodes []string) error {
 		}
 	}
 
+	// check resources configuration
+	if nConfig.Type == "ovs" && !nConfig.Resources.IsEmpty() {
+		return fmt.Errorf("[%s/resources] resources can not be set on ovswitch", name)
+	}
+	if err := checkResources(nConfig.Resources); err != nil {
+		return fmt.Errorf("[%s/resources] %w", name, err)
+	}
+
+	// check restart policy
+	if nConfig.Restart != "" && nConfig.Restart != "no" && nConfig.Restart != "on-failure" {
+		return fmt.Errorf("[%s/restart] policy '%s' is not valid (no or on-failure)", name, nConfig.Restart)
+	}
+	if nConfig.Type == "ovs" && nConfig.Restart == "on-failure" {
+		return fmt.Errorf("[%s/restart] restart policy can not be set on ovswitch", name)
+	}
+
+	// check persist mode
+	if nConfig.Persist != "" && nConfig.Persist != "none" && nConfig.Persist != "snapshot" {
+		return fmt.Errorf("[%s/persist] mode '%s' is not valid (none or snapshot)", name, nConfig.Persist)
+	}
+	if nConfig.Type == "ovs" && nConfig.Persist == "snapshot" {
+		return fmt.Errorf("[%s/persist] snapshot can not be enabled on ovswitch", name)
+	}
+
+	// check interfaces and routes
+	if nConfig.Type == "ovs" && (len(nConfig.Interfaces) > 0 || len(nConfig.Routes) > 0) {
+		return fmt.Errorf("[%s/interfaces] interfaces and routes can not be set on ovswitch", name)
+	}
+	if err := checkInterfacesConfig(nConfig); err != nil {
+		return fmt.Errorf("[%s/interfaces] %w", name, err)
+	}
+
+	// check sysctls
+	if nConfig.Type == "ovs" && len(nConfig.Sysctls) > 0 {
+		return fmt.Errorf("[%s/sysctls] sysctls can not be set on ovswitch", name)
+	}
+	for key := range nConfig.Sysctls {
+		if err := docker.CheckSysctl(key); err != nil {
+			return fmt.Errorf("[%s/sysctls] %w", name, err)
+		}
+	}
+
 	// check volumes configuration
 	for _, vBind := range nConfig.Volumes {
 		// only hostPath:containerPath syntax is allowed
@@ -74,6 +121,94 @@ func checkNodeConfig(name string, nConfig NodeConfig, nodes []string) error {
 	return nil
 }
 
+func checkResources(resources options.DockerNodeResources) error {
+	if resources.Cpus < 0 {
+		return fmt.Errorf("cpus must be >= 0")
+	}
+	if resources.Pids < 0 {
+		return fmt.Errorf("pids must be >= 0")
+	}
+	if resources.Cpuset != "" && !cpusetRE.MatchString(resources.Cpuset) {
+		return fmt.Errorf("cpuset '%s' is not valid", resources.Cpuset)
+	}
+	if _, err := resources.GetMemory(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkResourcesBudget checks that the resources requested by all docker
+// nodes do not exceed the budget set in the server configuration. The
+// budget applies to each project, not to the server as a whole. When a
+// resource is budgeted, every docker node must have a limit for it,
+// otherwise the node could consume the whole budget by itself
+func checkResourcesBudget(nodes map[string]NodeConfig) []error {
+	var errors []error
+	var cpus float64
+	var memory, pids int64
+
+	budget := options.ServerConfig.Docker.Budget
+	if budget.IsEmpty() {
+		return errors
+	}
+	budgetMemory, err := budget.GetMemory()
+	if err != nil {
+		return append(errors, fmt.Errorf("resources: server budget %w", err))
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		nConfig := nodes[name]
+		if !strings.HasPrefix(nConfig.Type, "docker.") {
+			continue
+		}
+
+		resources := nConfig.Resources
+		if dockerConfig, err := docker.GetDockerConfigFromType(strings.TrimPrefix(nConfig.Type, "docker.")); err == nil {
+			resources = dockerConfig.Resources.Merge(resources)
+		}
+		m, _ := resources.GetMemory()
+
+		var unlimited []string
+		if budget.Cpus > 0 && resources.Cpus == 0 {
+			unlimited = append(unlimited, "cpus")
+		}
+		if budgetMemory > 0 && m == 0 {
+			unlimited = append(unlimited, "memory")
+		}
+		if budget.Pids > 0 && resources.Pids == 0 {
+			unlimited = append(unlimited, "pids")
+		}
+		if len(unlimited) > 0 {
+			errors = append(errors, fmt.Errorf(
+				"node %s: %s must be limited, a resources budget is set on the server",
+				name, strings.Join(unlimited, ", ")))
+		}
+
+		cpus += resources.Cpus
+		pids += resources.Pids
+		memory += m
+	}
+
+	if budget.Cpus > 0 && cpus > budget.Cpus {
+		errors = append(errors, fmt.Errorf("resources: %g cpus requested, project budget is %g", cpus, budget.Cpus))
+	}
+	if budget.Pids > 0 && pids > budget.Pids {
+		errors = append(errors, fmt.Errorf("resources: %d pids requested, project budget is %d", pids, budget.Pids))
+	}
+	if budgetMemory > 0 && memory > budgetMemory {
+		errors = append(errors, fmt.Errorf("resources: %d bytes of memory requested, project budget is %s", memory, budget.Memory))
+	}
+
+	return errors
+}
+
 func checkBridgeConfig(name string, bConfig BridgeConfig, bridges []string) error {
 	if isEntryExist(bridges, name) {
 		return fmt.Errorf("bridge '%s' already exist", name)
@@ -83,6 +218,20 @@ func checkBridgeConfig(name string, bConfig BridgeConfig, bridges []string) erro
 		return fmt.Errorf("bridge: '%s' name field is not valid", name)
 	}
 
+	if err := checkMtu(bConfig.Mtu); err != nil {
+		return fmt.Errorf("bridge %s: %w", name, err)
+	}
+
+	if bConfig.Passthrough != "" {
+		if err := checkPassthroughConfig(name, bConfig); err != nil {
+			return err
+		}
+		// the host interface is in a node when the project is running
+		if bConfig.Passthrough == "direct" {
+			return nil
+		}
+	}
+
 	ns := link.GetRootNetns()
 	defer ns.Close()
 
@@ -93,6 +242,67 @@ func checkBridgeConfig(name string, bConfig BridgeConfig, bridges []string) erro
 	return nil
 }
 
+func checkMtu(mtu int) error {
+	if mtu != 0 && (mtu < 68 || mtu > 65535) {
+		return fmt.Errorf("mtu %d is not valid (68-65535)", mtu)
+	}
+	return nil
+}
+
+// checkMacAddresses checks that MAC addresses set explicitly on node
+// interfaces are unique in the topology
+func checkMacAddresses(nodes map[string]NodeConfig) []error {
+	var errors []error
+
+	used := make(map[string]string)
+	for name, nConfig := range nodes {
+		for ifName, ifConfig := range nConfig.Interfaces {
+			mac, err := net.ParseMAC(ifConfig.Mac)
+			if err != nil {
+				continue // empty or already reported
+			}
+
+			peer := name + "/" + ifName
+			if other, found := used[mac.String()]; found {
+				errors = append(errors, fmt.Errorf("mac address %s is used by %s and %s", mac, other, peer))
+				continue
+			}
+			used[mac.String()] = peer
+		}
+	}
+
+	return errors
+}
+
+func checkPortForwards(nodes map[string]NodeConfig) []error {
+	var errors []error
+
+	used := make(map[string]string)
+	for name, nConfig := range nodes {
+		if nConfig.Type == "ovs" && len(nConfig.Ports) > 0 {
+			errors = append(errors, fmt.Errorf("[%s/ports] ports can not be forwarded to ovswitch", name))
+			continue
+		}
+
+		for _, spec := range nConfig.Ports {
+			pf, err := ParsePortForward(spec)
+			if err != nil {
+				errors = append(errors, fmt.Errorf("[%s/ports] %w", name, err))
+				continue
+			}
+
+			hostPort := fmt.Sprintf("%d/%s", pf.HostPort, pf.Protocol)
+			if other, found := used[hostPort]; found {
+				errors = append(errors, fmt.Errorf("host port %s is forwarded to %s and %s", hostPort, other, name))
+				continue
+			}
+			used[hostPort] = name
+		}
+	}
+
+	return errors
+}
+
 func isEntryExist(nodes []string, node string) bool {
 	for _, n := range nodes {
 		if node == n {
@@ -121,6 +331,43 @@ func isPeerValid(nodes, peers []string, peer string) error {
 	return nil
 }
 
+func checkQoSConfig(label string, qos QoSConfig) []error {
+	var errors []error
+
+	// check netem parameters
+	if qos.Delay < 0 {
+		errors = append(errors, fmt.Errorf("%s delay must be >= 0 and specified in ms", label))
+	}
+	if qos.Jitter < 0 {
+		errors = append(errors, fmt.Errorf("%s jitter must be >= 0 and specified in ms", label))
+	}
+	if qos.Loss < 0 {
+		errors = append(errors, fmt.Errorf("%s loss must be >= 0 and specified in percent", label))
+	}
+	if qos.Jitter > 0 && qos.Delay == 0 {
+		errors = append(errors, fmt.Errorf("you must set delay with jitter"))
+	}
+	if qos.Loss > 100 {
+		errors = append(errors, fmt.Errorf("%s loss must be =< 100 and specified in percent", label))
+	}
+
+	// check tbf parameters
+	if qos.Rate < 0 {
+		errors = append(errors, fmt.Errorf("%s rate must be >= 0 and specified in kbps", label))
+	}
+	if qos.Rate > 0 && qos.Delay == 0 {
+		errors = append(errors, fmt.Errorf("delay must be > 0 when %s rate is configured", label))
+	}
+	if qos.Buffer < 0.0 {
+		errors = append(errors, fmt.Errorf("%s buffer must be >= 0 and specified in BDP scale factor", label))
+	}
+	if qos.Buffer > 0.0 && qos.Rate == 0 {
+		errors = append(errors, fmt.Errorf("%s rate must be > 0 when %s buffer is configured", label, label))
+	}
+
+	return errors
+}
+
 func CheckTopology(filepath string) (*NetemTopology, []error) {
 	var errors []error
 	var nodes []string
@@ -140,6 +387,14 @@ func CheckTopology(filepath string) (*NetemTopology, []error) {
 		return nil, errors
 	}
 
+	// check QoS profiles
+	for pName, pConfig := range topology.Profiles {
+		if !profileNameRE.MatchString(pName) || pName == NoProfile {
+			errors = append(errors, fmt.Errorf("profile: '%s' name field is not valid", pName))
+		}
+		errors = append(errors, checkQoSConfig("profile "+pName, pConfig)...)
+	}
+
 	// check nodes
 	for name, nConfig := range topology.Nodes {
 		if err := checkNodeConfig(name, nConfig, nodes); err != nil {
@@ -148,15 +403,54 @@ func CheckTopology(filepath string) (*NetemTopology, []error) {
 		nodes = append(nodes, name)
 	}
 
+	errors = append(errors, checkResourcesBudget(topology.Nodes)...)
+	errors = append(errors, checkMacAddresses(topology.Nodes)...)
+	errors = append(errors, checkPortForwards(topology.Nodes)...)
+
+	// check startup dependencies
+	if _, err := ComputeStartWaves(topology.Nodes); err != nil {
+		errors = append(errors, err)
+	}
+
+	// check lags, their member links are checked with other links
+	links := slices.Clone(topology.Links)
+	var lags []string
+	for _, lag := range topology.Lags {
+		if slices.Contains(lags, lag.Name) {
+			errors = append(errors, fmt.Errorf("lag '%s' already exist", lag.Name))
+		}
+		if err := checkLagConfig(lag); err != nil {
+			errors = append(errors, err)
+		}
+		lags = append(lags, lag.Name)
+		links = append(links, lag.Links...)
+	}
+
 	// check links
-	for _, link := range topology.Links {
+	for _, link := range links {
+		isRemote := IsRemotePeer(link.Peer1) || IsRemotePeer(link.Peer2)
+		if isRemote {
+			if err := checkRemoteLink(link); err != nil {
+				errors = append(errors, err)
+				continue
+			}
+		}
+		isProject := IsProjectPeer(link.Peer1) || IsProjectPeer(link.Peer2)
+		if isProject {
+			if err := checkProjectLink(link); err != nil {
+				errors = append(errors, err)
+				continue
+			}
+		}
 		if err := isPeerValid(nodes, peers, link.Peer1); err != nil {
 			errors = append(errors, err)
 			continue
 		}
-		if err := isPeerValid(nodes, peers, link.Peer2); err != nil {
-			errors = append(errors, err)
-			continue
+		if !isRemote && !isProject {
+			if err := isPeerValid(nodes, peers, link.Peer2); err != nil {
+				errors = append(errors, err)
+				continue
+			}
 		}
 
 		if link.Peer1 == link.Peer2 {
@@ -165,43 +459,32 @@ func CheckTopology(filepath string) (*NetemTopology, []error) {
 
 		peers = append(peers, link.Peer1, link.Peer2)
 
-		// check netem parameters
-		if link.Delay < 0 {
-			errors = append(errors, fmt.Errorf("link delay must be >= 0 and specified in ms"))
-		}
-		if link.Jitter < 0 {
-			errors = append(errors, fmt.Errorf("link jitter must be >= 0 and specified in ms"))
-		}
-		if link.Loss < 0 {
-			errors = append(errors, fmt.Errorf("link loss must be >= 0 and specified in percent"))
-		}
-		if link.Jitter > 0 && link.Delay == 0 {
-			errors = append(errors, fmt.Errorf("you must set delay with jitter"))
-		}
-		if link.Loss > 100 {
-			errors = append(errors, fmt.Errorf("link loss must be =< 100 and specified in percent"))
+		// check QoS parameters
+		if link.Profile != "" {
+			if err := link.SetProfile(link.Profile, topology.Profiles); err != nil {
+				errors = append(errors, fmt.Errorf("link %s-%s: %w", link.Peer1, link.Peer2, err))
+				continue
+			}
 		}
+		errors = append(errors, checkQoSConfig("link", link.GetQoS())...)
 
-		// check tbf parameters
-		if link.Rate < 0 {
-			errors = append(errors, fmt.Errorf("link rate must be >= 0 and specified in kbps"))
-		}
-		if link.Rate > 0 && link.Delay == 0 {
-			errors = append(errors, fmt.Errorf("delay must be > 0 when Link rate is configured"))
-		}
-		if link.Buffer < 0.0 {
-			errors = append(errors, fmt.Errorf("link buffer must be >= 0 and specified in BDP scale factor"))
-		}
-		if link.Buffer > 0.0 && link.Rate == 0 {
-			errors = append(errors, fmt.Errorf("link rate must be > 0 when Link buffer is configured"))
+		if err := checkMtu(link.Mtu); err != nil {
+			errors = append(errors, fmt.Errorf("link %s-%s: %w", link.Peer1, link.Peer2, err))
 		}
 	}
 
-	// check bridges
+	// check bridges, a host interface moved in a node can not be shared
+	hostIfs := make(map[string]int)
+	for _, bConfig := range topology.Bridges {
+		hostIfs[bConfig.Host]++
+	}
 	for bName, bConfig := range topology.Bridges {
 		if err := checkBridgeConfig(bName, bConfig, bridges); err != nil {
 			errors = append(errors, err)
 		}
+		if bConfig.Passthrough == "direct" && hostIfs[bConfig.Host] > 1 {
+			errors = append(errors, fmt.Errorf("bridge %s: host interface %s is used by another bridge", bName, bConfig.Host))
+		}
 
 		for _, peer := range bConfig.Interfaces {
 			if err := isPeerValid(nodes, peers, peer); err != nil {
@@ -214,5 +497,30 @@ func CheckTopology(filepath string) (*NetemTopology, []error) {
 		bridges = append(bridges, bName)
 	}
 
+	// check segments
+	for sName, sConfig := range topology.Segments {
+		if err := checkSegmentConfig(sName, sConfig, bridges); err != nil {
+			errors = append(errors, err)
+		}
+
+		for _, member := range sConfig.Members {
+			if err := isPeerValid(nodes, peers, member.Peer); err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			peers = append(peers, member.Peer)
+			errors = append(errors, checkQoSConfig("segment "+sName, member.QoSConfig)...)
+		}
+
+		bridges = append(bridges, sName)
+	}
+
+	// check addressing plan, only on a valid topology
+	if len(errors) == 0 && topology.Addressing.IsEnabled() {
+		if _, err := ComputeAddressingPlan(&topology); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
 	return &topology, errors
 }
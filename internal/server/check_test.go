@@ -0,0 +1,295 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mroy31/gonetem/internal/options"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCheck_ResourcesBudget(t *testing.T) {
+	options.InitServerConfig()
+	options.ServerConfig.Docker.Nodes.Host.Resources = options.DockerNodeResources{Cpus: 1, Memory: "1g"}
+	options.ServerConfig.Docker.Budget = options.DockerNodeResources{Cpus: 2, Memory: "3g"}
+	defer options.InitServerConfig()
+
+	nodes := map[string]NodeConfig{
+		"host1": {Type: "docker.host"},
+		"host2": {Type: "docker.host", Resources: options.DockerNodeResources{Memory: "512m"}},
+		"sw":    {Type: "ovs"},
+	}
+	if errors := checkResourcesBudget(nodes); len(errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", errors)
+	}
+
+	nodes["host3"] = NodeConfig{Type: "docker.host"}
+	if errors := checkResourcesBudget(nodes); len(errors) != 1 {
+		t.Fatalf("Only the cpus budget must be exceeded: %v", errors)
+	}
+
+	// a node without limits counts for the whole budget
+	delete(nodes, "host2")
+	delete(nodes, "host3")
+	nodes["R1"] = NodeConfig{Type: "docker.router"}
+	if errors := checkResourcesBudget(nodes); len(errors) != 1 {
+		t.Fatalf("An error is expected for a node without limits: %v", errors)
+	}
+	nodes["R1"] = NodeConfig{Type: "docker.router", Resources: options.DockerNodeResources{Cpus: 0.5, Memory: "256m"}}
+	if errors := checkResourcesBudget(nodes); len(errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", errors)
+	}
+
+	if err := checkResources(options.DockerNodeResources{Cpuset: "0-3,a"}); err == nil {
+		t.Errorf("An error is expected with a wrong cpuset")
+	}
+}
+
+func TestCheck_NodeOptions(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nConfig NodeConfig
+		valid   bool
+	}{
+		{"Restart: on-failure", NodeConfig{Type: "docker.router", Restart: "on-failure"}, true},
+		{"Restart: no", NodeConfig{Type: "docker.router", Restart: "no"}, true},
+		{"Restart: wrong policy", NodeConfig{Type: "docker.router", Restart: "always"}, false},
+		{"Restart: ovs", NodeConfig{Type: "ovs", Restart: "on-failure"}, false},
+		{"Persist: snapshot", NodeConfig{Type: "docker.host", Persist: "snapshot"}, true},
+		{"Persist: wrong mode", NodeConfig{Type: "docker.host", Persist: "full"}, false},
+		{"Persist: ovs", NodeConfig{Type: "ovs", Persist: "snapshot"}, false},
+		{"Sysctls: namespaced", NodeConfig{Type: "docker.host", Sysctls: map[string]string{"net.ipv4.conf.eth0.rp_filter": "0"}}, true},
+		{"Sysctls: not namespaced", NodeConfig{Type: "docker.host", Sysctls: map[string]string{"vm.swappiness": "10"}}, false},
+		{"Interfaces: valid", NodeConfig{Type: "docker.host", Interfaces: map[string]InterfaceConfig{"eth0": {Addresses: []string{"10.0.0.1/24", "2001:db8::1/64"}, Mtu: 9000, Mac: "02:00:00:00:00:01"}}}, true},
+		{"Interfaces: wrong name", NodeConfig{Type: "docker.host", Interfaces: map[string]InterfaceConfig{"lo": {Addresses: []string{"10.0.0.1/32"}}}}, false},
+		{"Interfaces: wrong address", NodeConfig{Type: "docker.host", Interfaces: map[string]InterfaceConfig{"eth0": {Addresses: []string{"10.0.0.1"}}}}, false},
+		{"Interfaces: wrong mtu", NodeConfig{Type: "docker.host", Interfaces: map[string]InterfaceConfig{"eth0": {Mtu: 20}}}, false},
+		{"Interfaces: ovs", NodeConfig{Type: "ovs", Interfaces: map[string]InterfaceConfig{"eth0": {Mtu: 9000}}}, false},
+		{"Vlans: valid", NodeConfig{Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth0": {Vlans: []VlanConfig{{Id: 10, Addresses: []string{"10.0.10.1/24"}}, {Id: 20}}}}}, true},
+		{"Vlans: wrong id", NodeConfig{Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth0": {Vlans: []VlanConfig{{Id: 4095}}}}}, false},
+		{"Vlans: duplicated id", NodeConfig{Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth0": {Vlans: []VlanConfig{{Id: 10}, {Id: 10}}}}}, false},
+		{"Routes: vlan device", NodeConfig{Type: "docker.router", Routes: []RouteConfig{{Destination: "10.1.0.0/16", Device: "eth0.10"}}}, true},
+		{"Routes: valid", NodeConfig{Type: "docker.host", Routes: []RouteConfig{{Destination: "default", Gateway: "10.0.0.254"}, {Destination: "192.168.0.0/16", Device: "eth1"}}}, true},
+		{"Routes: no gateway or device", NodeConfig{Type: "docker.host", Routes: []RouteConfig{{Destination: "default"}}}, false},
+		{"Routes: wrong destination", NodeConfig{Type: "docker.host", Routes: []RouteConfig{{Destination: "192.168.0.0", Gateway: "10.0.0.254"}}}, false},
+		{"Sysctls: ovs", NodeConfig{Type: "ovs", Sysctls: map[string]string{"net.ipv4.ip_forward": "1"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := checkNodeConfig("node", tt.nConfig, []string{})
+			if tt.valid && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			} else if !tt.valid && err == nil {
+				t.Errorf("An error is expected")
+			}
+		})
+	}
+}
+
+func TestCheck_MacAddresses(t *testing.T) {
+	nodes := map[string]NodeConfig{
+		"R1": {Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth0": {Mac: "02:00:00:00:00:01"}}},
+		"R2": {Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth0": {Mac: "02:00:00:00:00:02"}}},
+	}
+	if errors := checkMacAddresses(nodes); len(errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", errors)
+	}
+
+	nodes["R3"] = NodeConfig{Type: "docker.router", Interfaces: map[string]InterfaceConfig{"eth1": {Mac: "02:00:00:00:00:01"}}}
+	if errors := checkMacAddresses(nodes); len(errors) != 1 {
+		t.Errorf("A duplicated mac address must be reported: %v", errors)
+	}
+
+	if err := checkMtu(9000); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := checkMtu(70000); err == nil {
+		t.Errorf("An error is expected with a wrong mtu")
+	}
+}
+
+func TestCheck_LagConfig(t *testing.T) {
+	members := []LinkConfig{
+		{Peer1: "R1.0", Peer2: "sw1.0"},
+		{Peer1: "R1.1", Peer2: "sw1.1"},
+	}
+
+	tests := []struct {
+		desc  string
+		lag   LagConfig
+		valid bool
+	}{
+		{"Lag: default mode", LagConfig{Name: "bond0", Links: members}, true},
+		{"Lag: lacp fast", LagConfig{Name: "bond0", LacpRate: "fast", Links: members}, true},
+		{"Lag: active-backup", LagConfig{Name: "bond0", Mode: "active-backup", Links: members}, true},
+		{"Lag: wrong name", LagConfig{Name: "bond-0", Links: members}, false},
+		{"Lag: wrong mode", LagConfig{Name: "bond0", Mode: "broadcast", Links: members}, false},
+		{"Lag: lacp rate without lacp", LagConfig{Name: "bond0", Mode: "active-backup", LacpRate: "fast", Links: members}, false},
+		{"Lag: one link", LagConfig{Name: "bond0", Links: members[:1]}, false},
+		{"Lag: different nodes", LagConfig{Name: "bond0", Links: append(slices.Clone(members), LinkConfig{Peer1: "R2.0", Peer2: "sw1.2"})}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := checkLagConfig(tt.lag)
+			if tt.valid && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			} else if !tt.valid && err == nil {
+				t.Errorf("An error is expected")
+			}
+		})
+	}
+}
+
+func TestCheck_SegmentConfig(t *testing.T) {
+	data := `
+members:
+  - peer: R1.0
+  - peer: R2.0
+    delay: 20
+    loss: 1
+`
+	var sConfig SegmentConfig
+	if err := yaml.Unmarshal([]byte(data), &sConfig); err != nil {
+		t.Fatalf("Unable to parse segment: %v", err)
+	}
+	if len(sConfig.Members) != 2 || sConfig.Members[1].Delay != 20 || sConfig.Members[1].Loss != 1 {
+		t.Fatalf("Unexpected segment config: %v", sConfig)
+	}
+
+	if err := checkSegmentConfig("lan", sConfig, []string{}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := checkSegmentConfig("lan", sConfig, []string{"lan"}); err == nil {
+		t.Errorf("An error is expected with a name already used by a bridge")
+	}
+
+	sConfig.Members = sConfig.Members[:1]
+	if err := checkSegmentConfig("lan", sConfig, []string{}); err == nil {
+		t.Errorf("An error is expected with only one member")
+	}
+}
+
+func TestCheck_PassthroughConfig(t *testing.T) {
+	tests := []struct {
+		config BridgeConfig
+		valid  bool
+	}{
+		{BridgeConfig{Host: "eth0", Passthrough: "direct", Interfaces: []string{"R1.0"}}, true},
+		{BridgeConfig{Host: "eth0", Passthrough: "macvlan", Interfaces: []string{"R1.0", "R2.0"}, Mtu: 1400}, true},
+		{BridgeConfig{Host: "eth0", Passthrough: "ipvlan", Interfaces: []string{"R1.0"}}, true},
+		{BridgeConfig{Host: "eth0", Passthrough: "direct", Interfaces: []string{"R1.0", "R2.0"}}, false},
+		{BridgeConfig{Host: "eth0", Passthrough: "direct", Interfaces: []string{"R1.0"}, Mtu: 9000}, false},
+		{BridgeConfig{Host: "eth0", Passthrough: "vepa", Interfaces: []string{"R1.0"}}, false},
+	}
+
+	for _, tt := range tests {
+		err := checkPassthroughConfig("lab", tt.config)
+		if tt.valid && err != nil {
+			t.Errorf("Config %v must be valid: %v", tt.config, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("Config %v must be rejected", tt.config)
+		}
+	}
+}
+
+func TestCheck_RemoteLink(t *testing.T) {
+	remote, err := ParseRemotePeer("remote://serverB/lab-2/r5.0")
+	if err != nil {
+		t.Fatalf("Unable to parse remote peer: %v", err)
+	}
+	if remote.Server != "serverB" || remote.Project != "lab-2" || remote.Peer != "r5.0" {
+		t.Errorf("Unexpected remote peer: %v", remote)
+	}
+	if _, err := ParseRemotePeer("remote://serverB/r5.0"); err == nil {
+		t.Errorf("An error is expected with a remote peer without project")
+	}
+
+	saved := options.ServerConfig.Remote
+	defer func() { options.ServerConfig.Remote = saved }()
+	options.ServerConfig.Remote = options.RemoteOptions{
+		Vtep:    "127.0.0.1",
+		Servers: map[string]string{"serverB": "localhost:10111"},
+	}
+
+	tests := []struct {
+		config LinkConfig
+		valid  bool
+	}{
+		{LinkConfig{Peer1: "R1.0", Peer2: "remote://serverB/lab/r5.0"}, true},
+		{LinkConfig{Peer1: "R1.0", Peer2: "remote://serverC/lab/r5.0"}, false},
+		{LinkConfig{Peer1: "remote://serverB/lab/r5.0", Peer2: "R1.0"}, false},
+	}
+	for _, tt := range tests {
+		err := checkRemoteLink(tt.config)
+		if tt.valid && err != nil {
+			t.Errorf("Link %v must be valid: %v", tt.config, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("Link %v must be rejected", tt.config)
+		}
+	}
+
+	options.ServerConfig.Remote.Vtep = ""
+	if err := checkRemoteLink(tests[0].config); err == nil {
+		t.Errorf("An error is expected without vtep in server config")
+	}
+}
+
+func TestCheck_ProjectLink(t *testing.T) {
+	if IsProjectPeer("remote://serverB/lab/r5.0") {
+		t.Errorf("A remote peer is not a project peer")
+	}
+
+	tests := []struct {
+		config LinkConfig
+		valid  bool
+	}{
+		{LinkConfig{Peer1: "R1.0", Peer2: "lab-2/r5.0"}, true},
+		{LinkConfig{Peer1: "R1.0", Peer2: "lab-2/r5"}, false},
+		{LinkConfig{Peer1: "R1.0", Peer2: "lab-2/sub/r5.0"}, false},
+		{LinkConfig{Peer1: "lab-2/r5.0", Peer2: "R1.0"}, false},
+	}
+	for _, tt := range tests {
+		err := checkProjectLink(tt.config)
+		if tt.valid && err != nil {
+			t.Errorf("Link %v must be valid: %v", tt.config, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("Link %v must be rejected", tt.config)
+		}
+	}
+}
+
+func TestCheck_PortForwards(t *testing.T) {
+	pf, err := ParsePortForward("8080:80")
+	if err != nil {
+		t.Fatalf("Unable to parse port forward: %v", err)
+	}
+	if pf.HostPort != 8080 || pf.NodePort != 80 || pf.Protocol != "tcp" {
+		t.Errorf("Unexpected port forward: %v", pf)
+	}
+	for _, spec := range []string{"8080", "8080:80/sctp", "70000:80", "8080:0/udp"} {
+		if _, err := ParsePortForward(spec); err == nil {
+			t.Errorf("Port %s must be rejected", spec)
+		}
+	}
+
+	nodes := map[string]NodeConfig{
+		"web": {Type: "docker.server", Ports: []string{"8080:80/tcp", "5353:53/udp"}},
+		"dns": {Type: "docker.server", Ports: []string{"8053:53/tcp"}},
+	}
+	if errors := checkPortForwards(nodes); len(errors) > 0 {
+		t.Errorf("Port forwards must be valid: %v", errors)
+	}
+
+	nodes["dns"] = NodeConfig{Type: "docker.server", Ports: []string{"5353:53/udp"}}
+	if errors := checkPortForwards(nodes); len(errors) != 1 {
+		t.Errorf("A host port forwarded twice must be rejected")
+	}
+
+	nodes["sw1"] = NodeConfig{Type: "ovs", Ports: []string{"2222:22"}}
+	delete(nodes, "dns")
+	if errors := checkPortForwards(nodes); len(errors) != 1 {
+		t.Errorf("Ports can not be forwarded to ovswitch")
+	}
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ComputeStartWaves sorts the nodes in waves from their dependencies:
+// the nodes of a wave only depend on nodes of the previous waves, so
+// they can be started in parallel once the previous waves are up
+func ComputeStartWaves(nodes map[string]NodeConfig) ([][]string, error) {
+	remaining := make(map[string]int)
+	dependents := make(map[string][]string)
+
+	for name, nConfig := range nodes {
+		remaining[name] = 0
+		for _, dep := range nConfig.DependsOn {
+			if _, found := nodes[dep]; !found {
+				return nil, fmt.Errorf("[%s/dependsOn] node '%s' not exist", name, dep)
+			}
+			if isEntryExist(dependents[dep], name) {
+				continue
+			}
+			remaining[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var waves [][]string
+	for len(remaining) > 0 {
+		var wave []string
+		for name, count := range remaining {
+			if count == 0 {
+				wave = append(wave, name)
+			}
+		}
+
+		if len(wave) == 0 {
+			var cycle []string
+			for name := range remaining {
+				cycle = append(cycle, name)
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("dependsOn: dependency cycle between nodes %s", strings.Join(cycle, ", "))
+		}
+
+		sort.Strings(wave)
+		for _, name := range wave {
+			delete(remaining, name)
+			for _, dependent := range dependents[name] {
+				remaining[dependent]--
+			}
+		}
+		waves = append(waves, wave)
+	}
+
+	return waves, nil
+}
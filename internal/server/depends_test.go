@@ -0,0 +1,54 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDepends_StartWaves(t *testing.T) {
+	nodes := map[string]NodeConfig{
+		"dhcp":   {Type: "docker.server"},
+		"rr":     {Type: "docker.router"},
+		"R1":     {Type: "docker.router", DependsOn: []string{"rr"}},
+		"R2":     {Type: "docker.router", DependsOn: []string{"rr", "rr"}},
+		"client": {Type: "docker.host", DependsOn: []string{"dhcp", "R1"}},
+	}
+
+	waves, err := ComputeStartWaves(nodes)
+	if err != nil {
+		t.Fatalf("Unable to compute waves: %v", err)
+	}
+
+	expected := [][]string{{"dhcp", "rr"}, {"R1", "R2"}, {"client"}}
+	if !reflect.DeepEqual(waves, expected) {
+		t.Errorf("Wrong waves %v != %v", waves, expected)
+	}
+}
+
+func TestDepends_Errors(t *testing.T) {
+	tests := []struct {
+		desc  string
+		nodes map[string]NodeConfig
+	}{
+		{"Depends: unknown node", map[string]NodeConfig{
+			"R1": {DependsOn: []string{"R2"}},
+		}},
+		{"Depends: self dependency", map[string]NodeConfig{
+			"R1": {DependsOn: []string{"R1"}},
+		}},
+		{"Depends: cycle", map[string]NodeConfig{
+			"R1": {DependsOn: []string{"R3"}},
+			"R2": {DependsOn: []string{"R1"}},
+			"R3": {DependsOn: []string{"R2"}},
+			"R4": {},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if _, err := ComputeStartWaves(tt.nodes); err == nil {
+				t.Errorf("An error is expected")
+			}
+		})
+	}
+}
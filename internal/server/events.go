@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mroy31/gonetem/internal/docker"
+	"github.com/mroy31/gonetem/internal/link"
+)
+
+const (
+	maxRestartAttempts = 3
+	eventsRetryDelay   = 5 * time.Second
+	// restarts of a node are counted again once it has run this delay
+	restartsResetDelay = 60 * time.Second
+)
+
+type NodeEventCode int
+
+const (
+	NODE_DIED NodeEventCode = iota
+	NODE_RESTARTED
+	NODE_RESTART_FAILED
+)
+
+type NodeEvent struct {
+	Node    string
+	Code    NodeEventCode
+	Message string
+}
+
+// SubscribeEvents returns a channel receiving the events of the nodes
+// and a function to call to unsubscribe
+func (t *NetemTopologyManager) SubscribeEvents() (chan NodeEvent, func()) {
+	ch := make(chan NodeEvent, 16)
+
+	t.eventsLock.Lock()
+	defer t.eventsLock.Unlock()
+	t.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		t.eventsLock.Lock()
+		defer t.eventsLock.Unlock()
+
+		// the channel is already closed if the topology has been closed
+		if _, found := t.subscribers[ch]; found {
+			delete(t.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// closeSubscribers closes the channels of all subscribers, to signal them
+// that no more events will be sent
+func (t *NetemTopologyManager) closeSubscribers() {
+	t.eventsLock.Lock()
+	defer t.eventsLock.Unlock()
+
+	for ch := range t.subscribers {
+		close(ch)
+	}
+	t.subscribers = make(map[chan NodeEvent]struct{})
+}
+
+func (t *NetemTopologyManager) emitEvent(event NodeEvent) {
+	t.eventsLock.Lock()
+	defer t.eventsLock.Unlock()
+
+	for ch := range t.subscribers {
+		// never block the watcher for a slow subscriber
+		select {
+		case ch <- event:
+		default:
+			t.logger.Warnf("Event lost for a subscriber: %s", event.Message)
+		}
+	}
+}
+
+func (t *NetemTopologyManager) startEventsWatcher() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.eventsCancel = cancel
+
+	t.eventsWg.Add(1)
+	go func() {
+		defer t.eventsWg.Done()
+		for {
+			if err := t.watchEvents(ctx); err != nil {
+				t.logger.Warnf("Docker events watcher: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventsRetryDelay):
+			}
+		}
+	}()
+}
+
+// stopEventsWatcher stops the watcher and waits for the restarts in
+// progress. It must not be called with the topology lock held
+func (t *NetemTopologyManager) stopEventsWatcher() {
+	if t.eventsCancel != nil {
+		t.eventsCancel()
+		t.eventsCancel = nil
+	}
+	t.eventsWg.Wait()
+}
+
+func (t *NetemTopologyManager) watchEvents(ctx context.Context) error {
+	client, err := docker.NewDockerClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	msgCh, errCh := client.DieEvents(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		case msg := <-msgCh:
+			t.handleDieEvent(ctx, msg.Actor.ID, msg.Actor.Attributes["exitCode"])
+		}
+	}
+}
+
+func (t *NetemTopologyManager) handleDieEvent(ctx context.Context, containerID, exitCode string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, node := range t.nodes {
+		dNode, ok := node.Instance.(*docker.DockerNode)
+		if !ok || dNode.ID != containerID {
+			continue
+		}
+
+		if dNode.HandleDie() {
+			t.detachCrashedPeers(dNode)
+
+			t.emitEvent(NodeEvent{
+				Node:    dNode.GetName(),
+				Code:    NODE_DIED,
+				Message: fmt.Sprintf("node %s died with exit code %s", dNode.GetName(), exitCode),
+			})
+
+			if node.Config.Restart == "on-failure" && exitCode != "0" {
+				t.eventsWg.Add(1)
+				go func() {
+					defer t.eventsWg.Done()
+					t.restartCrashedNode(ctx, dNode)
+				}()
+			}
+		}
+		return
+	}
+}
+
+// detachCrashedPeers forgets the interfaces linked to a crashed node,
+// the veth pairs have been destroyed with the netns of its container
+func (t *NetemTopologyManager) detachCrashedPeers(node INetemNode) {
+	detach := func(peer NetemLinkPeer) {
+		ifName := peer.Node.GetInterfaceName(peer.IfIndex)
+		if err := peer.Node.DetachInterface(ifName); err != nil {
+			t.logger.Warnf("Unable to detach interface %s: %v", ifName, err)
+		}
+	}
+
+	for _, l := range t.links {
+		switch node {
+		case l.Peer1.Node:
+			detach(l.Peer2)
+		case l.Peer2.Node:
+			detach(l.Peer1)
+		default:
+			continue
+		}
+		// qdiscs are created again with the veth
+		l.HasPeer1Netem, l.HasPeer2Netem = false, false
+		l.HasPeer1Tbf, l.HasPeer2Tbf = false, false
+	}
+
+	t.detachSegmentPeers(node)
+	t.detachPassthroughPeers(node)
+
+	projectLinksLock.Lock()
+	defer projectLinksLock.Unlock()
+
+	for _, pl := range t.projectLinks {
+		if !pl.IsConnected() {
+			continue
+		}
+		switch node {
+		case pl.Link.Peer1.Node:
+			detach(pl.Link.Peer2)
+		case pl.Link.Peer2.Node:
+			detach(pl.Link.Peer1)
+		}
+	}
+}
+
+func (t *NetemTopologyManager) restartCrashedNode(ctx context.Context, node *docker.DockerNode) {
+	t.eventsLock.Lock()
+	// a node running long enough after its last restart has recovered
+	if time.Since(t.lastRestarts[node.GetName()]) > restartsResetDelay {
+		t.restarts[node.GetName()] = 0
+	}
+	t.restarts[node.GetName()]++
+	attempt := t.restarts[node.GetName()]
+	t.eventsLock.Unlock()
+
+	if attempt > maxRestartAttempts {
+		t.emitEvent(NodeEvent{
+			Node:    node.GetName(),
+			Code:    NODE_RESTART_FAILED,
+			Message: fmt.Sprintf("node %s: too many restarts, give up", node.GetName()),
+		})
+		return
+	}
+
+	t.lock.Lock()
+	// the topology is being closed, or the node has been started by the
+	// user in the meantime
+	if ctx.Err() != nil || node.IsRunning() {
+		t.lock.Unlock()
+		return
+	}
+	_, err := t.startNode(node)
+	t.lock.Unlock()
+	if err != nil {
+		t.emitEvent(NodeEvent{
+			Node:    node.GetName(),
+			Code:    NODE_RESTART_FAILED,
+			Message: fmt.Sprintf("node %s: restart failed: %v", node.GetName(), err),
+		})
+		return
+	}
+
+	t.eventsLock.Lock()
+	t.lastRestarts[node.GetName()] = time.Now()
+	t.eventsLock.Unlock()
+
+	t.emitEvent(NodeEvent{
+		Node:    node.GetName(),
+		Code:    NODE_RESTARTED,
+		Message: fmt.Sprintf("node %s restarted (attempt %d)", node.GetName(), attempt),
+	})
+}
+
+// restoreNodeLinks creates again the links, bridge and management
+// interfaces of a node which have been destroyed when it has crashed
+func (t *NetemTopologyManager) restoreNodeLinks(node INetemNode) error {
+	for _, l := range t.links {
+		if l.Peer1.Node == node || l.Peer2.Node == node {
+			if err := t.setupLink(l, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	for _, br := range t.bridges {
+		for _, peer := range br.Peers {
+			if peer.Node != node {
+				continue
+			}
+
+			if br.Config.Passthrough != "" {
+				if err := t.setupPassthroughPeer(br, peer, true); err != nil {
+					return err
+				}
+				continue
+			}
+
+			brId, err := link.GetBridge(br.Name, rootNs)
+			if err != nil {
+				return err
+			}
+			if err := t.setupBridgePeer(br, brId, peer, rootNs, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := t.restoreSegmentPeers(node); err != nil {
+		return err
+	}
+	if err := t.restoreRemoteLinks(node); err != nil {
+		return err
+	}
+	if err := t.restoreProjectLinks(node); err != nil {
+		return err
+	}
+
+	for idx := range t.nodes {
+		if t.nodes[idx].Instance == node && t.nodes[idx].Config.Mgnt.Enable {
+			return t.setupMgntLink(&t.nodes[idx])
+		}
+	}
+
+	return nil
+}
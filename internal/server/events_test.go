@@ -0,0 +1,22 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestEvents_CloseSubscribers(t *testing.T) {
+	topo := &NetemTopologyManager{subscribers: make(map[chan NodeEvent]struct{})}
+
+	ch, unsubscribe := topo.SubscribeEvents()
+	topo.emitEvent(NodeEvent{Node: "R1", Code: NODE_DIED})
+	if event := <-ch; event.Node != "R1" || event.Code != NODE_DIED {
+		t.Fatalf("Unexpected event: %v", event)
+	}
+
+	topo.closeSubscribers()
+	if _, ok := <-ch; ok {
+		t.Fatalf("Channel of the subscriber is not closed")
+	}
+	// the channel must not be closed twice
+	unsubscribe()
+}
@@ -0,0 +1,446 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mroy31/gonetem/internal/link"
+)
+
+const (
+	graphStateRunning = "running"
+	graphStateStopped = "stopped"
+	graphStateUp      = "up"
+	graphStateDown    = "down"
+
+	graphMgntNode = "mgnt"
+
+	graphBridgeKind      = "bridge"
+	graphPassthroughKind = "passthrough"
+	graphSegmentKind     = "segment"
+	graphRemoteKind      = "remote"
+	graphProjectKind     = "project"
+)
+
+type GraphNode struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind"`
+	State string `json:"state,omitempty"`
+}
+
+type GraphLink struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	SourceIf string `json:"sourceIf,omitempty"`
+	TargetIf string `json:"targetIf,omitempty"`
+	Label    string `json:"label,omitempty"`
+	State    string `json:"state,omitempty"`
+}
+
+// TopologyGraph is a description of the topology used to draw it
+type TopologyGraph struct {
+	Name  string      `json:"name"`
+	Nodes []GraphNode `json:"nodes"`
+	Links []GraphLink `json:"links"`
+}
+
+func getGraphNodeKind(nType string) string {
+	return strings.TrimPrefix(nType, "docker.")
+}
+
+func getQoSLabel(qos QoSConfig) string {
+	var parts []string
+	if qos.Delay > 0 {
+		delay := fmt.Sprintf("%dms", qos.Delay)
+		if qos.Jitter > 0 {
+			delay += fmt.Sprintf("±%d", qos.Jitter)
+		}
+		parts = append(parts, delay)
+	}
+	if qos.Loss > 0 {
+		parts = append(parts, fmt.Sprintf("%g%%", qos.Loss))
+	}
+	if qos.Rate > 0 {
+		parts = append(parts, fmt.Sprintf("%dkbps", qos.Rate))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getLinkLabel(lConfig LinkConfig) string {
+	label1 := getQoSLabel(lConfig.GetPeer1QoS())
+	label2 := getQoSLabel(lConfig.GetPeer2QoS())
+	if label1 == label2 {
+		return label1
+	}
+	return label1 + " / " + label2
+}
+
+func getPeerIfState(peer NetemLinkPeer) link.IfState {
+	states := peer.Node.GetInterfacesState()
+	if state, found := states[peer.Node.GetInterfaceName(peer.IfIndex)]; found {
+		return state
+	}
+	if state, found := states[strconv.Itoa(peer.IfIndex)]; found {
+		return state
+	}
+	return link.IFSTATE_DOWN
+}
+
+func getPeersState(peers ...NetemLinkPeer) string {
+	for _, peer := range peers {
+		if !peer.Node.IsRunning() || getPeerIfState(peer) == link.IFSTATE_DOWN {
+			return graphStateDown
+		}
+	}
+	return graphStateUp
+}
+
+// GetGraph builds the graph of the topology. If withState is true, nodes
+// and links are annotated with their current state
+func (t *NetemTopologyManager) GetGraph(name string, withState bool) *TopologyGraph {
+	graph := &TopologyGraph{Name: name}
+	withState = withState && t.running.Load()
+
+	for _, node := range t.nodes {
+		gNode := GraphNode{
+			Name: node.Instance.GetName(),
+			Kind: getGraphNodeKind(node.Config.Type),
+		}
+		if withState {
+			gNode.State = graphStateStopped
+			if node.Instance.IsRunning() {
+				gNode.State = graphStateRunning
+			}
+		}
+		graph.Nodes = append(graph.Nodes, gNode)
+
+		if node.Config.Mgnt.Enable && t.mgntNet != nil {
+			graph.Links = append(graph.Links, GraphLink{
+				Source: gNode.Name,
+				Target: graphMgntNode,
+				Label:  node.Config.Mgnt.Address,
+			})
+		}
+	}
+
+	for _, l := range t.links {
+		gLink := GraphLink{
+			Source:   l.Peer1.Node.GetName(),
+			Target:   l.Peer2.Node.GetName(),
+			SourceIf: strconv.Itoa(l.Peer1.IfIndex),
+			TargetIf: strconv.Itoa(l.Peer2.IfIndex),
+			Label:    getLinkLabel(l.Config),
+		}
+		if withState {
+			gLink.State = getPeersState(l.Peer1, l.Peer2)
+		}
+		graph.Links = append(graph.Links, gLink)
+	}
+
+	for _, br := range t.bridges {
+		brName := "bridge " + br.HostInterface
+		kind := graphBridgeKind
+		if br.Config.Passthrough != "" {
+			kind = graphPassthroughKind
+		}
+		graph.Nodes = append(graph.Nodes, GraphNode{Name: brName, Kind: kind})
+
+		for _, peer := range br.Peers {
+			gLink := GraphLink{
+				Source:   peer.Node.GetName(),
+				Target:   brName,
+				SourceIf: strconv.Itoa(peer.IfIndex),
+				Label:    br.Config.Passthrough,
+			}
+			if withState {
+				gLink.State = getPeersState(peer)
+			}
+			graph.Links = append(graph.Links, gLink)
+		}
+	}
+
+	for _, seg := range t.segments {
+		segName := "segment " + seg.Name
+		graph.Nodes = append(graph.Nodes, GraphNode{Name: segName, Kind: graphSegmentKind})
+
+		for pIdx, peer := range seg.Peers {
+			gLink := GraphLink{
+				Source:   peer.Node.GetName(),
+				Target:   segName,
+				SourceIf: strconv.Itoa(peer.IfIndex),
+				Label:    getQoSLabel(seg.Config.Members[pIdx].QoSConfig),
+			}
+			if withState {
+				gLink.State = getPeersState(peer)
+			}
+			graph.Links = append(graph.Links, gLink)
+		}
+	}
+
+	// nodes of other projects or servers are added once, even if
+	// several links target them
+	external := make(map[string]bool)
+	addExternalNode := func(name, kind string) {
+		if !external[name] {
+			external[name] = true
+			graph.Nodes = append(graph.Nodes, GraphNode{Name: name, Kind: kind})
+		}
+	}
+
+	for _, rl := range t.getRemoteLinks() {
+		// links accepted from another server only know its vtep
+		target, targetIf := "remote "+rl.RemoteVtep.String(), ""
+		if !rl.Accepted {
+			target = fmt.Sprintf("%s/%s/%s", rl.Remote.Server, rl.Remote.Project, rl.Remote.Peer)
+			target, targetIf, _ = strings.Cut(target, ".")
+		}
+		addExternalNode(target, graphRemoteKind)
+
+		gLink := GraphLink{
+			Source:   rl.Peer.Node.GetName(),
+			Target:   target,
+			SourceIf: strconv.Itoa(rl.Peer.IfIndex),
+			TargetIf: targetIf,
+			Label:    getLinkLabel(rl.Config),
+		}
+		if withState {
+			gLink.State = getPeersState(rl.Peer)
+		}
+		graph.Links = append(graph.Links, gLink)
+	}
+
+	for _, pl := range t.projectLinks {
+		local, peer := pl.GetPeers(t)
+		source, sourceIf, _ := strings.Cut(local, ".")
+		target, targetIf, _ := strings.Cut(peer, ".")
+		addExternalNode(target, graphProjectKind)
+
+		gLink := GraphLink{
+			Source:   source,
+			Target:   target,
+			SourceIf: sourceIf,
+			TargetIf: targetIf,
+			Label:    getLinkLabel(pl.Link.Config),
+		}
+		if withState {
+			gLink.State = graphStateDown
+			if pl.IsConnected() {
+				gLink.State = getPeersState(pl.Link.Peer1, pl.Link.Peer2)
+			}
+		}
+		graph.Links = append(graph.Links, gLink)
+	}
+
+	if t.mgntNet != nil {
+		graph.Nodes = append(graph.Nodes, GraphNode{Name: graphMgntNode, Kind: graphMgntNode})
+	}
+
+	graph.Sort()
+	return graph
+}
+
+// Sort orders nodes by name to get a stable rendering
+func (g *TopologyGraph) Sort() {
+	sort.SliceStable(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Name < g.Nodes[j].Name
+	})
+}
+
+func (g *TopologyGraph) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+func getNodeColor(node GraphNode) string {
+	switch node.State {
+	case graphStateRunning:
+		return "#c8e6c9"
+	case graphStateStopped:
+		return "#e0e0e0"
+	}
+	return "#e3f2fd"
+}
+
+func getLinkColor(l GraphLink) string {
+	if l.State == graphStateDown {
+		return "#d32f2f"
+	}
+	return "#424242"
+}
+
+func (g *TopologyGraph) ToDot() []byte {
+	var b strings.Builder
+
+	shapes := map[string]string{
+		"router":             "circle",
+		"host":               "box",
+		"server":             "box3d",
+		"ovs":                "box",
+		"p4sw":               "diamond",
+		graphBridgeKind:      "hexagon",
+		graphPassthroughKind: "hexagon",
+		graphSegmentKind:     "octagon",
+		graphRemoteKind:      "box",
+		graphProjectKind:     "folder",
+		graphMgntNode:        "ellipse",
+	}
+
+	fmt.Fprintf(&b, "graph %q {\n", g.Name)
+	b.WriteString("  node [fontname=\"Helvetica\", style=filled];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+	for _, node := range g.Nodes {
+		shape, found := shapes[node.Kind]
+		if !found {
+			shape = "box"
+		}
+		style := "filled"
+		switch node.Kind {
+		case "ovs":
+			style = "filled,rounded"
+		case graphMgntNode, graphPassthroughKind, graphRemoteKind, graphProjectKind:
+			style = "filled,dashed"
+		}
+		fmt.Fprintf(
+			&b, "  %q [shape=%s, style=%q, fillcolor=%q, tooltip=%q];\n",
+			node.Name, shape, style, getNodeColor(node), node.Kind)
+	}
+	for _, l := range g.Links {
+		attrs := []string{fmt.Sprintf("color=%q", getLinkColor(l))}
+		if l.SourceIf != "" {
+			attrs = append(attrs, fmt.Sprintf("taillabel=%q", l.SourceIf))
+		}
+		if l.TargetIf != "" {
+			attrs = append(attrs, fmt.Sprintf("headlabel=%q", l.TargetIf))
+		}
+		if l.Label != "" {
+			attrs = append(attrs, fmt.Sprintf("label=%q", l.Label))
+		}
+		if l.Target == graphMgntNode {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&b, "  %q -- %q [%s];\n", l.Source, l.Target, strings.Join(attrs, ", "))
+	}
+	b.WriteString("}\n")
+
+	return []byte(b.String())
+}
+
+type svgPoint struct {
+	X, Y float64
+}
+
+func (p svgPoint) towards(target svgPoint, ratio float64) svgPoint {
+	return svgPoint{
+		X: p.X + (target.X-p.X)*ratio,
+		Y: p.Y + (target.Y-p.Y)*ratio,
+	}
+}
+
+// ToSVG renders the graph with a circular layout, no external tool
+// is required
+func (g *TopologyGraph) ToSVG() []byte {
+	const (
+		margin     = 80.0
+		nodeRadius = 24.0
+	)
+
+	radius := math.Max(150, float64(len(g.Nodes))*3*nodeRadius/math.Pi)
+	size := 2 * (radius + margin)
+	center := svgPoint{X: size / 2, Y: size / 2}
+
+	positions := make(map[string]svgPoint)
+	for idx, node := range g.Nodes {
+		angle := 2*math.Pi*float64(idx)/float64(len(g.Nodes)) - math.Pi/2
+		positions[node.Name] = svgPoint{
+			X: center.X + radius*math.Cos(angle),
+			Y: center.Y + radius*math.Sin(angle),
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(
+		&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.0f\" height=\"%.0f\" viewBox=\"0 0 %.0f %.0f\" font-family=\"Helvetica, Arial, sans-serif\">\n",
+		size, size, size, size)
+	fmt.Fprintf(&b, "  <title>%s</title>\n", html.EscapeString(g.Name))
+	b.WriteString("  <rect width=\"100%\" height=\"100%\" fill=\"white\"/>\n")
+
+	// draw links first, so nodes are drawn over them
+	for _, l := range g.Links {
+		src, srcFound := positions[l.Source]
+		dst, dstFound := positions[l.Target]
+		if !srcFound || !dstFound {
+			continue
+		}
+
+		dash := ""
+		if l.Target == graphMgntNode {
+			dash = " stroke-dasharray=\"6,4\""
+		}
+		fmt.Fprintf(
+			&b, "  <line x1=\"%.1f\" y1=\"%.1f\" x2=\"%.1f\" y2=\"%.1f\" stroke=\"%s\" stroke-width=\"2\"%s/>\n",
+			src.X, src.Y, dst.X, dst.Y, getLinkColor(l), dash)
+
+		ratio := (nodeRadius + 12) / math.Max(math.Hypot(dst.X-src.X, dst.Y-src.Y), 1)
+		if l.SourceIf != "" {
+			p := src.towards(dst, ratio)
+			fmt.Fprintf(&b, "  <text x=\"%.1f\" y=\"%.1f\" font-size=\"10\" text-anchor=\"middle\">%s</text>\n", p.X, p.Y, l.SourceIf)
+		}
+		if l.TargetIf != "" {
+			p := dst.towards(src, ratio)
+			fmt.Fprintf(&b, "  <text x=\"%.1f\" y=\"%.1f\" font-size=\"10\" text-anchor=\"middle\">%s</text>\n", p.X, p.Y, l.TargetIf)
+		}
+		if l.Label != "" {
+			p := src.towards(dst, 0.5)
+			fmt.Fprintf(
+				&b, "  <text x=\"%.1f\" y=\"%.1f\" font-size=\"10\" fill=\"#1565c0\" text-anchor=\"middle\">%s</text>\n",
+				p.X, p.Y-4, html.EscapeString(l.Label))
+		}
+	}
+
+	for _, node := range g.Nodes {
+		p := positions[node.Name]
+		fill := getNodeColor(node)
+		style := fmt.Sprintf("fill=\"%s\" stroke=\"#37474f\" stroke-width=\"1.5\"", fill)
+
+		fmt.Fprintf(&b, "  <g><title>%s (%s)</title>\n", html.EscapeString(node.Name), html.EscapeString(node.Kind))
+		switch node.Kind {
+		case "router":
+			fmt.Fprintf(&b, "    <circle cx=\"%.1f\" cy=\"%.1f\" r=\"%.1f\" %s/>\n", p.X, p.Y, nodeRadius, style)
+		case "ovs":
+			fmt.Fprintf(
+				&b, "    <rect x=\"%.1f\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" rx=\"8\" %s/>\n",
+				p.X-1.5*nodeRadius, p.Y-nodeRadius/2, 3*nodeRadius, nodeRadius, style)
+		case "p4sw":
+			fmt.Fprintf(
+				&b, "    <polygon points=\"%.1f,%.1f %.1f,%.1f %.1f,%.1f %.1f,%.1f\" %s/>\n",
+				p.X, p.Y-nodeRadius, p.X+nodeRadius, p.Y, p.X, p.Y+nodeRadius, p.X-nodeRadius, p.Y, style)
+		case graphBridgeKind, graphPassthroughKind, graphSegmentKind, graphMgntNode:
+			dash := ""
+			if node.Kind == graphMgntNode || node.Kind == graphPassthroughKind {
+				dash = " stroke-dasharray=\"6,4\""
+			}
+			fmt.Fprintf(
+				&b, "    <ellipse cx=\"%.1f\" cy=\"%.1f\" rx=\"%.1f\" ry=\"%.1f\" %s%s/>\n",
+				p.X, p.Y, 1.5*nodeRadius, nodeRadius*0.8, style, dash)
+		default:
+			dash := ""
+			if node.Kind == graphRemoteKind || node.Kind == graphProjectKind {
+				dash = " stroke-dasharray=\"6,4\""
+			}
+			fmt.Fprintf(
+				&b, "    <rect x=\"%.1f\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" %s%s/>\n",
+				p.X-nodeRadius, p.Y-nodeRadius*0.75, 2*nodeRadius, 1.5*nodeRadius, style, dash)
+		}
+		fmt.Fprintf(
+			&b, "    <text x=\"%.1f\" y=\"%.1f\" font-size=\"12\" text-anchor=\"middle\">%s</text>\n",
+			p.X, p.Y+nodeRadius+14, html.EscapeString(node.Name))
+		b.WriteString("  </g>\n")
+	}
+	b.WriteString("</svg>\n")
+
+	return []byte(b.String())
+}
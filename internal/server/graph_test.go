@@ -0,0 +1,148 @@
+package server
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func getTestGraph() *TopologyGraph {
+	graph := &TopologyGraph{
+		Name: "test",
+		Nodes: []GraphNode{
+			{Name: "sw", Kind: "ovs"},
+			{Name: "R1", Kind: "router", State: graphStateRunning},
+			{Name: "host", Kind: "host", State: graphStateStopped},
+		},
+		Links: []GraphLink{
+			{Source: "R1", Target: "sw", SourceIf: "0", TargetIf: "0", Label: "10ms 1%", State: graphStateUp},
+			{Source: "host", Target: "sw", SourceIf: "0", TargetIf: "1", State: graphStateDown},
+		},
+	}
+	graph.Sort()
+	return graph
+}
+
+func TestGraph_LinkLabel(t *testing.T) {
+	lConfig := LinkConfig{Delay: 10, Jitter: 2, Loss: 1}
+	if label := getLinkLabel(lConfig); label != "10ms±2 1%" {
+		t.Errorf("Unexpected link label: %s", label)
+	}
+
+	lConfig.Peer2QoS = QoSConfig{Rate: 1024}
+	if label := getLinkLabel(lConfig); label != "10ms±2 1% / 1024kbps" {
+		t.Errorf("Unexpected link label: %s", label)
+	}
+}
+
+func TestGraph_Export(t *testing.T) {
+	graph := getTestGraph()
+
+	dot := string(graph.ToDot())
+	for _, expected := range []string{
+		`graph "test" {`,
+		`"R1" [shape=circle`,
+		`"host" -- "sw" [color="#d32f2f", taillabel="0", headlabel="1"];`,
+	} {
+		if !strings.Contains(dot, expected) {
+			t.Errorf("DOT export does not contain '%s':\n%s", expected, dot)
+		}
+	}
+
+	var svg struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(graph.ToSVG(), &svg); err != nil || svg.XMLName.Local != "svg" {
+		t.Errorf("SVG export is not valid: %v", err)
+	}
+
+	data, err := graph.ToJSON()
+	if err != nil {
+		t.Fatalf("Unable to export graph in json: %v", err)
+	}
+	var decoded TopologyGraph
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("JSON export is not valid: %v", err)
+	}
+	if len(decoded.Nodes) != 3 || decoded.Nodes[0].Name != "R1" {
+		t.Errorf("Unexpected nodes in JSON export: %v", decoded.Nodes)
+	}
+}
+
+// graphTestNode is a node only able to give its name, enough to build a
+// graph without state
+type graphTestNode struct {
+	INetemNode
+	name string
+}
+
+func (n *graphTestNode) GetName() string {
+	return n.name
+}
+
+func TestGraph_Topology(t *testing.T) {
+	r1 := &graphTestNode{name: "R1"}
+	r2 := &graphTestNode{name: "R2"}
+	topo := &NetemTopologyManager{
+		nodes: []NetemNode{
+			{Instance: r1, Config: NodeConfig{Type: "docker.router"}},
+			{Instance: r2, Config: NodeConfig{Type: "docker.router"}},
+		},
+		bridges: []*NetemBridge{{
+			HostInterface: "eth1",
+			Peers:         []NetemLinkPeer{{Node: r1, IfIndex: 1}},
+			Config:        BridgeConfig{Passthrough: "macvlan"},
+		}},
+		segments: []*NetemSegment{{
+			Name:  "lan",
+			Peers: []NetemLinkPeer{{Node: r1, IfIndex: 2}, {Node: r2, IfIndex: 2}},
+			Config: SegmentConfig{Members: []SegmentMember{
+				{Peer: "R1.2"},
+				{Peer: "R2.2", QoSConfig: QoSConfig{Delay: 10}},
+			}},
+		}},
+		remoteLinks: []*NetemRemoteLink{{
+			Peer:   NetemLinkPeer{Node: r1, IfIndex: 3},
+			Remote: RemotePeer{Server: "srv", Project: "prj", Peer: "R3.0"},
+		}},
+	}
+	topo.projectLinks = []*NetemProjectLink{{
+		Link: &NetemLink{
+			Peer1:  NetemLinkPeer{Node: r2, IfIndex: 3},
+			Config: LinkConfig{Peer1: "R2.3", Peer2: "other/R4.1"},
+		},
+		Owner:   topo,
+		Project: "other",
+		Peer:    "R4.1",
+	}}
+
+	graph := topo.GetGraph("test", false)
+	kinds := make(map[string]string)
+	for _, node := range graph.Nodes {
+		kinds[node.Name] = node.Kind
+	}
+	for name, kind := range map[string]string{
+		"bridge eth1": graphPassthroughKind,
+		"segment lan": graphSegmentKind,
+		"srv/prj/R3":  graphRemoteKind,
+		"other/R4":    graphProjectKind,
+	} {
+		if kinds[name] != kind {
+			t.Errorf("Node %s of kind %s not found in graph: %v", name, kind, graph.Nodes)
+		}
+	}
+
+	expected := []GraphLink{
+		{Source: "R1", Target: "bridge eth1", SourceIf: "1", Label: "macvlan"},
+		{Source: "R2", Target: "segment lan", SourceIf: "2", Label: "10ms"},
+		{Source: "R1", Target: "srv/prj/R3", SourceIf: "3", TargetIf: "0"},
+		{Source: "R2", Target: "other/R4", SourceIf: "3", TargetIf: "1"},
+	}
+	for _, gLink := range expected {
+		if !slices.Contains(graph.Links, gLink) {
+			t.Errorf("Link %v not found in graph: %v", gLink, graph.Links)
+		}
+	}
+}
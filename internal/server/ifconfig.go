@@ -0,0 +1,332 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/mroy31/gonetem/internal/link"
+	"github.com/vishvananda/netns"
+)
+
+var (
+	ifNameRE   = regexp.MustCompile(`^eth\d+$`)
+	routeDevRE = regexp.MustCompile(`^eth\d+(\.\d+)?$`)
+)
+
+func checkInterfacesConfig(nConfig NodeConfig) error {
+	for ifName, ifConfig := range nConfig.Interfaces {
+		if !ifNameRE.MatchString(ifName) {
+			return fmt.Errorf("interface name '%s' is not valid (ethN required)", ifName)
+		}
+		for _, addr := range ifConfig.Addresses {
+			if _, _, err := net.ParseCIDR(addr); err != nil {
+				return fmt.Errorf("%s: address '%s' is not valid: %v", ifName, addr, err)
+			}
+		}
+		if err := checkMtu(ifConfig.Mtu); err != nil {
+			return fmt.Errorf("%s: %w", ifName, err)
+		}
+		if ifConfig.Mac != "" {
+			if _, err := net.ParseMAC(ifConfig.Mac); err != nil {
+				return fmt.Errorf("%s: mac address '%s' is not valid: %v", ifName, ifConfig.Mac, err)
+			}
+		}
+
+		vlanIds := make(map[int]bool)
+		for _, vlan := range ifConfig.Vlans {
+			if vlan.Id < 1 || vlan.Id > 4094 {
+				return fmt.Errorf("%s: vlan id %d is not valid (1-4094)", ifName, vlan.Id)
+			}
+			if vlanIds[vlan.Id] {
+				return fmt.Errorf("%s: vlan %d is declared twice", ifName, vlan.Id)
+			}
+			vlanIds[vlan.Id] = true
+
+			for _, addr := range vlan.Addresses {
+				if _, _, err := net.ParseCIDR(addr); err != nil {
+					return fmt.Errorf("%s.%d: address '%s' is not valid: %v", ifName, vlan.Id, addr, err)
+				}
+			}
+		}
+	}
+
+	for _, route := range nConfig.Routes {
+		if route.Destination != "default" {
+			if _, _, err := net.ParseCIDR(route.Destination); err != nil {
+				return fmt.Errorf("route destination '%s' is not valid: %v", route.Destination, err)
+			}
+		}
+		if route.Gateway == "" && route.Device == "" {
+			return fmt.Errorf("route %s: gateway or device is required", route.Destination)
+		}
+		if route.Gateway != "" && net.ParseIP(route.Gateway) == nil {
+			return fmt.Errorf("route %s: gateway '%s' is not valid", route.Destination, route.Gateway)
+		}
+		if route.Device != "" && !routeDevRE.MatchString(route.Device) {
+			return fmt.Errorf("route %s: device '%s' is not valid (ethN or ethN.vlan required)", route.Destination, route.Device)
+		}
+	}
+
+	return nil
+}
+
+// configureNodeNetwork applies the interfaces and routes declared in the
+// topology to a running node. Interfaces not yet attached to the node
+// are ignored, the configuration is applied again when links are added
+func (t *NetemTopologyManager) configureNodeNetwork(node INetemNode) error {
+	if err := t.configureNodeInterfaces(node); err != nil {
+		return err
+	}
+	return t.configureNodeRoutes(node)
+}
+
+func (t *NetemTopologyManager) configureNodeInterfaces(node INetemNode) error {
+	nConfig := t.GetNodeConfig(node.GetName())
+	if nConfig == nil || !node.IsRunning() || len(nConfig.Interfaces) == 0 {
+		return nil
+	}
+
+	ns, err := node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	ifNames := make([]string, 0, len(nConfig.Interfaces))
+	for ifName := range nConfig.Interfaces {
+		ifNames = append(ifNames, ifName)
+	}
+	sort.Strings(ifNames)
+
+	for _, ifName := range ifNames {
+		ifConfig := nConfig.Interfaces[ifName]
+		if !link.IsLinkExist(ifName, ns) {
+			continue
+		}
+
+		if ifConfig.Mtu > 0 {
+			if err := link.SetInterfaceMTU(ifName, ns, ifConfig.Mtu); err != nil {
+				return err
+			}
+		}
+		if ifConfig.Mac != "" {
+			if err := link.SetInterfaceMAC(ifName, ns, ifConfig.Mac); err != nil {
+				return err
+			}
+		}
+		if ifConfig.Description != "" {
+			if err := link.SetInterfaceAlias(ifName, ns, ifConfig.Description); err != nil {
+				return err
+			}
+		}
+		for _, addr := range ifConfig.Addresses {
+			if err := link.IpAddressReplace(ifName, ns, addr); err != nil {
+				return err
+			}
+		}
+		if err := configureVlans(ifName, ifConfig.Vlans, ns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configureVlans creates the vlan sub-interfaces of an interface, the
+// parent may be attached to the node after the loading of its config
+func configureVlans(parent string, vlans []VlanConfig, ns netns.NsHandle) error {
+	for _, vlan := range vlans {
+		name := fmt.Sprintf("%s.%d", parent, vlan.Id)
+		if !link.IsLinkExist(name, ns) {
+			if _, err := link.CreateVlan(name, parent, vlan.Id, ns); err != nil {
+				return err
+			}
+		}
+		if err := link.SetInterfaceState(name, ns, link.IFSTATE_UP); err != nil {
+			return err
+		}
+		for _, addr := range vlan.Addresses {
+			if err := link.IpAddressReplace(name, ns, addr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// configureNodeRoutes adds the routes declared in the topology, it has
+// to be called once the configuration of the node is loaded since routes
+// may use vlan interfaces
+func (t *NetemTopologyManager) configureNodeRoutes(node INetemNode) error {
+	nConfig := t.GetNodeConfig(node.GetName())
+	if nConfig == nil || !node.IsRunning() || len(nConfig.Routes) == 0 {
+		return nil
+	}
+
+	ns, err := node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	for _, route := range nConfig.Routes {
+		if route.Device != "" && !link.IsLinkExist(route.Device, ns) {
+			continue
+		}
+		err := link.RouteReplace(ns, link.Route{
+			Destination: route.Destination,
+			Gateway:     route.Gateway,
+			Device:      route.Device,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readNodeNetwork updates the declared interfaces and routes of a
+// running node with the ones set in the kernel, so the changes made
+// in the node are kept when the project is saved. Only nodes using the
+// declarative configuration are updated, it returns true if the
+// configuration has changed
+func (t *NetemTopologyManager) readNodeNetwork(node *NetemNode) (bool, error) {
+	nConfig := &node.Config
+	if !node.Instance.IsRunning() {
+		return false, nil
+	}
+	if len(nConfig.Interfaces) == 0 && len(nConfig.Routes) == 0 {
+		return false, nil
+	}
+
+	ns, err := node.Instance.GetNetns()
+	if err != nil {
+		return false, err
+	}
+	defer ns.Close()
+
+	// keep configuration of interfaces not attached to the node
+	ifStates := node.Instance.GetInterfacesState()
+	interfaces := make(map[string]InterfaceConfig)
+	for ifName, ifConfig := range nConfig.Interfaces {
+		if _, found := ifStates[ifName]; !found {
+			interfaces[ifName] = ifConfig
+		}
+	}
+
+	for ifName := range ifStates {
+		if !ifNameRE.MatchString(ifName) {
+			continue
+		}
+
+		kConfig, err := link.GetInterfaceConfig(ifName, ns)
+		if err != nil {
+			return false, err
+		}
+
+		ifConfig := InterfaceConfig{Addresses: kConfig.Addresses}
+		if len(ifConfig.Addresses) == 0 {
+			ifConfig.Addresses = nil
+		}
+		if kConfig.Mtu != link.DefaultMTU {
+			ifConfig.Mtu = kConfig.Mtu
+		}
+		// MAC addresses of veth are random and alias is also set from
+		// link descriptions, keep them only if they are declared
+		if mac := nConfig.Interfaces[ifName].Mac; mac != "" {
+			ifConfig.Mac = kConfig.Mac
+			// keep the declared format if the address has not changed
+			if sameMAC(mac, kConfig.Mac) {
+				ifConfig.Mac = mac
+			}
+		}
+		if nConfig.Interfaces[ifName].Description != "" {
+			ifConfig.Description = kConfig.Alias
+		}
+		// vlans are kept as declared
+		ifConfig.Vlans = nConfig.Interfaces[ifName].Vlans
+
+		if !reflect.DeepEqual(ifConfig, InterfaceConfig{}) {
+			interfaces[ifName] = ifConfig
+		}
+	}
+
+	kRoutes, err := link.GetStaticRoutes(ns)
+	if err != nil {
+		return false, err
+	}
+
+	// declared routes are kept if they are still set in the kernel, or if
+	// they can not be set for now since their device is absent or their
+	// gateway is unreachable, like interfaces not attached to the node
+	var routes []RouteConfig
+	for _, route := range nConfig.Routes {
+		found := slices.ContainsFunc(kRoutes, func(kRoute link.Route) bool {
+			return sameRoute(route, kRoute)
+		})
+		if found ||
+			(route.Device != "" && !link.IsLinkExist(route.Device, ns)) ||
+			(route.Gateway != "" && !link.IsGatewayReachable(ns, route.Gateway)) {
+			routes = append(routes, route)
+		}
+	}
+	for _, kRoute := range kRoutes {
+		declared := slices.ContainsFunc(nConfig.Routes, func(route RouteConfig) bool {
+			return sameRoute(route, kRoute)
+		})
+		if !declared {
+			routes = append(routes, RouteConfig{
+				Destination: kRoute.Destination,
+				Gateway:     kRoute.Gateway,
+				Device:      kRoute.Device,
+			})
+		}
+	}
+
+	if len(interfaces) == 0 {
+		interfaces = nil
+	}
+	changed := !reflect.DeepEqual(interfaces, nConfig.Interfaces) || !reflect.DeepEqual(routes, nConfig.Routes)
+	nConfig.Interfaces = interfaces
+	nConfig.Routes = routes
+
+	return changed, nil
+}
+
+func sameMAC(mac1, mac2 string) bool {
+	hw1, err1 := net.ParseMAC(mac1)
+	hw2, err2 := net.ParseMAC(mac2)
+	return err1 == nil && err2 == nil && bytes.Equal(hw1, hw2)
+}
+
+// sameRoute returns true if the declared route is the kernel one, the
+// device is not read from the kernel for routes with a gateway
+func sameRoute(route RouteConfig, kRoute link.Route) bool {
+	if !sameDestination(route.Destination, kRoute.Destination) || !sameIP(route.Gateway, kRoute.Gateway) {
+		return false
+	}
+	return route.Gateway != "" || route.Device == kRoute.Device
+}
+
+func sameDestination(dst1, dst2 string) bool {
+	if dst1 == dst2 {
+		return true
+	}
+	_, net1, err1 := net.ParseCIDR(dst1)
+	_, net2, err2 := net.ParseCIDR(dst2)
+	return err1 == nil && err2 == nil && net1.String() == net2.String()
+}
+
+func sameIP(ip1, ip2 string) bool {
+	if ip1 == ip2 {
+		return true
+	}
+	return net.ParseIP(ip1) != nil && net.ParseIP(ip1).Equal(net.ParseIP(ip2))
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mroy31/gonetem/internal/link"
+)
+
+var (
+	lagNameRE = regexp.MustCompile(`^[a-zA-Z]\w{0,9}$`)
+	lagModes  = []string{"802.3ad", "active-backup", "balance-xor"}
+)
+
+func getBondOptions(l LagConfig) link.BondOptions {
+	return link.BondOptions{Mode: l.Mode, LacpRate: l.LacpRate}
+}
+
+type NetemLag struct {
+	Config LagConfig
+	Links  []*NetemLink
+}
+
+// GetPeerIfIndexes returns the interfaces of the node aggregated in the lag
+func (l *NetemLag) GetPeerIfIndexes(node INetemNode) []int {
+	var ifIndexes []int
+	for _, nLink := range l.Links {
+		if nLink.Peer1.Node == node {
+			ifIndexes = append(ifIndexes, nLink.Peer1.IfIndex)
+		} else if nLink.Peer2.Node == node {
+			ifIndexes = append(ifIndexes, nLink.Peer2.IfIndex)
+		}
+	}
+	return ifIndexes
+}
+
+func checkLagConfig(lag LagConfig) error {
+	if !lagNameRE.MatchString(lag.Name) {
+		return fmt.Errorf("lag: '%s' name field is not valid", lag.Name)
+	}
+	if lag.Mode != "" && !slices.Contains(lagModes, lag.Mode) {
+		return fmt.Errorf("lag %s: mode '%s' is not valid (%s)", lag.Name, lag.Mode, strings.Join(lagModes, ", "))
+	}
+	if lag.LacpRate != "" && lag.LacpRate != "slow" && lag.LacpRate != "fast" {
+		return fmt.Errorf("lag %s: lacp rate '%s' is not valid (slow or fast)", lag.Name, lag.LacpRate)
+	}
+	if lag.LacpRate != "" && getBondOptions(lag).GetMode() != "802.3ad" {
+		return fmt.Errorf("lag %s: lacp rate can only be set with 802.3ad mode", lag.Name)
+	}
+	if len(lag.Links) < 2 {
+		return fmt.Errorf("lag %s: at least 2 links are required", lag.Name)
+	}
+
+	for _, l := range lag.Links {
+		if IsRemotePeer(l.Peer1) || IsRemotePeer(l.Peer2) {
+			return fmt.Errorf("lag %s: remote links can not be aggregated", lag.Name)
+		}
+		if IsProjectPeer(l.Peer1) || IsProjectPeer(l.Peer2) {
+			return fmt.Errorf("lag %s: project links can not be aggregated", lag.Name)
+		}
+	}
+
+	// all members have to connect the same nodes
+	node1 := strings.Split(lag.Links[0].Peer1, ".")[0]
+	node2 := strings.Split(lag.Links[0].Peer2, ".")[0]
+	if node1 == node2 {
+		return fmt.Errorf("lag %s: links must connect two different nodes", lag.Name)
+	}
+	for _, l := range lag.Links[1:] {
+		if strings.Split(l.Peer1, ".")[0] != node1 || strings.Split(l.Peer2, ".")[0] != node2 {
+			return fmt.Errorf("lag %s: all links must connect %s to %s", lag.Name, node1, node2)
+		}
+	}
+
+	return nil
+}
+
+// configureNodeLags creates the bonds of the node, it has to be done each
+// time the node is started since bonds of docker nodes are destroyed
+// with their netns
+func (t *NetemTopologyManager) configureNodeLags(node INetemNode) error {
+	if !node.IsRunning() {
+		return nil
+	}
+
+	for _, lag := range t.lags {
+		ifIndexes := lag.GetPeerIfIndexes(node)
+		if len(ifIndexes) == 0 {
+			continue
+		}
+
+		if err := node.SetupBond(lag.Config.Name, ifIndexes, getBondOptions(lag.Config)); err != nil {
+			return fmt.Errorf("unable to setup lag %s on node %s: %w", lag.Config.Name, node.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// isLagMember returns true if the link is a member of a lag
+func (t *NetemTopologyManager) isLagMember(l *NetemLink) bool {
+	for _, lag := range t.lags {
+		if slices.Contains(lag.Links, l) {
+			return true
+		}
+	}
+	return false
+}
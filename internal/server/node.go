@@ -1,9 +1,11 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
 	"sync"
 
 	"github.com/moby/term"
@@ -86,15 +88,22 @@ type INetemNode interface {
 	IsRunning() bool
 	Start() error
 	Stop() error
+	IsPaused() bool
+	Pause() error
+	Resume() error
 	GetNetns() (netns.NsHandle, error)
 	GetInterfaceName(ifIndex int) string
 	AttachMgntInterface(ifName string, ns netns.NsHandle, IPAddress string) error
 	AttachInterface(ifName string, ifIndex int, configure bool) error
+	DetachInterface(ifName string) error
 	ConfigureInterfaces() error
+	SetupBond(name string, ifIndexes []int, opts link.BondOptions) error
+	WaitReady(timeout int) error
 	LoadConfig(confPath string, timeout int) ([]string, error)
 	ExecCommand(cmd []string, in io.ReadCloser, out io.Writer, tty bool, ttyHeight uint, ttyWidth uint, resizeCh chan term.Winsize) error
 	GetConsoleCmd(shell bool) ([]string, error)
 	Capture(ifIndex int, out io.Writer) error
+	Logs(ctx context.Context, opts docker.LogsOptions, stdout, stderr io.Writer) error
 	CopyFrom(srcPath, destPath string) error
 	CopyTo(srcPath, destPath string) error
 	ReadConfigFiles(confDir string, timeout int) (map[string][]byte, error)
@@ -117,6 +126,8 @@ func CreateNode(prjID string, name string, shortName string, config NodeConfig)
 			Mpls:      config.Mpls,
 			Vrfs:      config.Vrfs,
 			Volumes:   config.Volumes,
+			Resources: config.Resources,
+			Sysctls:   config.Sysctls,
 		}
 		for _, group := range config.Vrrps {
 			options.Vrrps = append(options.Vrrps, docker.VrrpOptions{
@@ -126,6 +137,21 @@ func CreateNode(prjID string, name string, shortName string, config NodeConfig)
 			})
 		}
 
+		ifNames := make([]string, 0, len(config.Interfaces))
+		for ifName := range config.Interfaces {
+			ifNames = append(ifNames, ifName)
+		}
+		sort.Strings(ifNames)
+		for _, ifName := range ifNames {
+			for _, vlan := range config.Interfaces[ifName].Vlans {
+				options.Vlans = append(options.Vlans, docker.VlanOptions{
+					Parent:    ifName,
+					Id:        vlan.Id,
+					Addresses: vlan.Addresses,
+				})
+			}
+		}
+
 		return docker.NewDockerNode(prjID, groups[1], options)
 	}
 
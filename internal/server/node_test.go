@@ -4,7 +4,9 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/mroy31/gonetem/internal/link"
 	"github.com/mroy31/gonetem/internal/utils"
+	"gopkg.in/yaml.v3"
 )
 
 func TestIdGenerator_ShortName(t *testing.T) {
@@ -23,3 +25,102 @@ func TestIdGenerator_ShortName(t *testing.T) {
 		t.Fatalf("value od shortName1 is not expected: %s != 1nnnn", shortName2)
 	}
 }
+
+func TestNodeConfig_Metadata(t *testing.T) {
+	data := []byte(`
+nodes:
+  R1:
+    type: docker.router
+    description: edge router
+    icon: router
+    position:
+      x: 10.5
+      y: 20
+    labels:
+      site: paris
+links:
+  - peer1: R1.0
+    peer2: R2.0
+    description: uplink
+bridges:
+  br:
+    host: eth0
+    description: lab access
+`)
+
+	var topology NetemTopology
+	if err := yaml.Unmarshal(data, &topology); err != nil {
+		t.Fatalf("Unable to parse topology: %v", err)
+	}
+
+	// metadata must round-trip like in SynchroniseTopology
+	out, err := yaml.Marshal(&topology)
+	if err != nil {
+		t.Fatalf("Unable to marshal topology: %v", err)
+	}
+	topology = NetemTopology{}
+	if err := yaml.Unmarshal(out, &topology); err != nil {
+		t.Fatalf("Unable to parse marshalled topology: %v", err)
+	}
+
+	nConfig := topology.Nodes["R1"]
+	if nConfig.Description != "edge router" || nConfig.Icon != "router" || nConfig.Labels["site"] != "paris" {
+		t.Errorf("Node metadata are not kept: %v", nConfig)
+	}
+	if nConfig.Position == nil || nConfig.Position.X != 10.5 || nConfig.Position.Y != 20 {
+		t.Errorf("Node position is not kept: %v", nConfig.Position)
+	}
+	if topology.Links[0].Description != "uplink" {
+		t.Errorf("Link description is not kept")
+	}
+	if topology.Bridges["br"].Description != "lab access" {
+		t.Errorf("Bridge description is not kept")
+	}
+}
+
+func TestNodeConfig_SameRoute(t *testing.T) {
+	tests := []struct {
+		route    RouteConfig
+		kRoute   link.Route
+		expected bool
+	}{
+		{
+			route:    RouteConfig{Destination: "10.0.0.0/8", Gateway: "192.168.1.1", Device: "eth0"},
+			kRoute:   link.Route{Destination: "10.0.0.0/8", Gateway: "192.168.1.1"},
+			expected: true,
+		},
+		{
+			route:    RouteConfig{Destination: "2001:db8::/32", Gateway: "2001:DB8:1::1"},
+			kRoute:   link.Route{Destination: "2001:db8::/32", Gateway: "2001:db8:1::1"},
+			expected: true,
+		},
+		{
+			route:    RouteConfig{Destination: "10.1.1.1/24", Device: "eth1"},
+			kRoute:   link.Route{Destination: "10.1.1.0/24", Device: "eth1"},
+			expected: true,
+		},
+		{
+			route:    RouteConfig{Destination: "10.1.1.0/24", Device: "eth1"},
+			kRoute:   link.Route{Destination: "10.1.1.0/24", Device: "eth2"},
+			expected: false,
+		},
+		{
+			route:    RouteConfig{Destination: "default", Gateway: "192.168.1.1"},
+			kRoute:   link.Route{Destination: "default", Gateway: "192.168.1.254"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		if sameRoute(tt.route, tt.kRoute) != tt.expected {
+			t.Errorf("sameRoute(%v, %v) != %v", tt.route, tt.kRoute, tt.expected)
+		}
+	}
+
+	if !sameMAC("02:AA:BB:CC:DD:EE", "02:aa:bb:cc:dd:ee") {
+		t.Errorf("MAC addresses must be compared without case")
+	}
+	if sameMAC("02:aa:bb:cc:dd:ee", "02:aa:bb:cc:dd:ef") {
+		t.Errorf("Different MAC addresses must not be the same")
+	}
+}
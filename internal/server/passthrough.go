@@ -0,0 +1,165 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mroy31/gonetem/internal/link"
+)
+
+var passthroughModes = []string{"direct", "macvlan", "ipvlan"}
+
+func checkPassthroughConfig(name string, bConfig BridgeConfig) error {
+	if !slices.Contains(passthroughModes, bConfig.Passthrough) {
+		return fmt.Errorf(
+			"bridge %s: passthrough '%s' is not valid (%s)",
+			name, bConfig.Passthrough, strings.Join(passthroughModes, ", "))
+	}
+
+	if bConfig.Passthrough == "direct" {
+		if len(bConfig.Interfaces) != 1 {
+			return fmt.Errorf("bridge %s: direct passthrough requires exactly one interface", name)
+		}
+		if bConfig.Mtu != 0 {
+			return fmt.Errorf("bridge %s: mtu can not be set with direct passthrough", name)
+		}
+	}
+
+	return nil
+}
+
+// setupPassthrough connects the node interfaces of the bridge directly
+// to the host interface, without Linux bridge
+func (t *NetemTopologyManager) setupPassthrough(br *NetemBridge) error {
+	for _, peer := range br.Peers {
+		if err := t.setupPassthroughPeer(br, peer, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *NetemTopologyManager) setupPassthroughPeer(br *NetemBridge, peer NetemLinkPeer, configure bool) error {
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	peerNetns, err := peer.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peerNetns.Close()
+
+	peerIfName := peer.Node.GetInterfaceName(peer.IfIndex)
+	switch br.Config.Passthrough {
+	case "direct":
+		hostIfName := br.HostInterface
+		if br.hostIf != nil {
+			// the node has crashed, the interface is back in the root
+			// netns but may have been renamed by the kernel
+			if hostIfName, err = link.FindHostInterface(br.hostIf, rootNs); err != nil {
+				return err
+			}
+		}
+
+		hostIf, err := link.MoveHostInterface(hostIfName, rootNs, peerNetns, peerIfName)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to move host interface %s to %s.%d: %v",
+				hostIfName, peer.Node.GetName(), peer.IfIndex, err,
+			)
+		}
+		if br.hostIf == nil {
+			br.hostIf = hostIf
+		}
+	default:
+		err := link.CreateHostChild(
+			peerIfName, br.Config.Passthrough,
+			br.HostInterface, rootNs,
+			peerNetns,
+			t.getInterfaceMAC(peer.Node, peer.IfIndex),
+			br.Config.Mtu,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to create link %s-%s.%d: %v",
+				br.HostInterface, peer.Node.GetName(), peer.IfIndex, err,
+			)
+		}
+	}
+
+	if br.Config.Description != "" {
+		if err := link.SetInterfaceAlias(peerIfName, peerNetns, br.Config.Description); err != nil {
+			return err
+		}
+	}
+
+	if err := peer.Node.AttachInterface(peerIfName, peer.IfIndex, configure); err != nil {
+		return err
+	}
+	if configure {
+		return t.configureNodeNetwork(peer.Node)
+	}
+
+	return nil
+}
+
+// detachPassthroughPeers gives back to the host the interfaces moved in a
+// crashed node. The kernel has moved them in the root netns without their
+// name and state, they are moved again in the node when it restarts
+func (t *NetemTopologyManager) detachPassthroughPeers(node INetemNode) {
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	for _, br := range t.bridges {
+		if br.hostIf == nil || br.Peers[0].Node != node {
+			continue
+		}
+
+		ifName, err := link.FindHostInterface(br.hostIf, rootNs)
+		if err == nil {
+			err = link.RestoreHostInterface(br.hostIf, ifName, rootNs, rootNs)
+		}
+		if err != nil {
+			t.logger.Warnf("Error when restoring host interface %s: %v", br.hostIf.Name, err)
+		}
+	}
+}
+
+// closePassthroughs moves back host interfaces in the root netns, it has
+// to be done before closing nodes. Children interfaces are destroyed with
+// the netns of the nodes
+func (t *NetemTopologyManager) closePassthroughs() {
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	for _, br := range t.bridges {
+		if br.hostIf == nil {
+			continue
+		}
+
+		peer := br.Peers[0]
+		err := func() error {
+			peerNetns, err := peer.Node.GetNetns()
+			if err == nil {
+				defer peerNetns.Close()
+				err = link.RestoreHostInterface(br.hostIf, peer.Node.GetInterfaceName(peer.IfIndex), peerNetns, rootNs)
+			}
+			if err == nil {
+				return nil
+			}
+
+			// the interface may already be back in the root netns
+			ifName, findErr := link.FindHostInterface(br.hostIf, rootNs)
+			if findErr != nil {
+				return err
+			}
+			return link.RestoreHostInterface(br.hostIf, ifName, rootNs, rootNs)
+		}()
+		if err != nil {
+			t.logger.Warnf("Error when restoring host interface %s: %v", br.hostIf.Name, err)
+		}
+		br.hostIf = nil
+	}
+}
@@ -0,0 +1,402 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mroy31/gonetem/internal/link"
+	"github.com/mroy31/gonetem/internal/proto"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	nodeDialTimeout   = 5 * time.Second
+	udpSessionTimeout = 60 * time.Second
+	udpBufferSize     = 65535
+)
+
+var portForwardRE = regexp.MustCompile(`^(\d+):(\d+)(?:/(tcp|udp))?$`)
+
+// PortForward is a port of the server host forwarded to a port of a node,
+// declared with the format <hostPort>:<nodePort>[/tcp|udp]
+type PortForward struct {
+	HostPort int
+	NodePort int
+	Protocol string
+}
+
+func (p PortForward) String() string {
+	return fmt.Sprintf("%d:%d/%s", p.HostPort, p.NodePort, p.Protocol)
+}
+
+func ParsePortForward(spec string) (PortForward, error) {
+	groups := portForwardRE.FindStringSubmatch(spec)
+	if len(groups) != 4 {
+		return PortForward{}, fmt.Errorf("port '%s' is not valid (<hostPort>:<nodePort>[/tcp|udp] required)", spec)
+	}
+
+	hostPort, _ := strconv.Atoi(groups[1])
+	nodePort, _ := strconv.Atoi(groups[2])
+	for _, port := range []int{hostPort, nodePort} {
+		if port < 1 || port > 65535 {
+			return PortForward{}, fmt.Errorf("port '%s' is not valid (1-65535 required)", spec)
+		}
+	}
+
+	protocol := groups[3]
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	return PortForward{HostPort: hostPort, NodePort: nodePort, Protocol: protocol}, nil
+}
+
+// NetemPortForward proxies connections received on the host port to the
+// loopback of the node, the connection to the node is opened from its
+// netns so the node does not need any route to the server
+type NetemPortForward struct {
+	PortForward
+	Node INetemNode
+
+	listener   net.Listener
+	packetConn net.PacketConn
+	logger     *logrus.Entry
+
+	lock  sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+func (f *NetemPortForward) dialNode() (net.Conn, error) {
+	if !f.Node.IsRunning() {
+		return nil, fmt.Errorf("node %s is not running", f.Node.GetName())
+	}
+
+	ns, err := f.Node.GetNetns()
+	if err != nil {
+		return nil, err
+	}
+	defer ns.Close()
+
+	return link.DialInNetns(ns, f.Protocol, fmt.Sprintf("127.0.0.1:%d", f.NodePort), nodeDialTimeout)
+}
+
+// track registers a connection closed with the forward, it returns false
+// if the forward is already closed
+func (f *NetemPortForward) track(conns ...net.Conn) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.conns == nil {
+		return false
+	}
+	for _, conn := range conns {
+		f.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (f *NetemPortForward) untrack(conns ...net.Conn) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, conn := range conns {
+		conn.Close()
+		delete(f.conns, conn)
+	}
+}
+
+func (f *NetemPortForward) Start() error {
+	address := fmt.Sprintf(":%d", f.HostPort)
+	f.conns = make(map[net.Conn]struct{})
+
+	// the calling thread may still be in the netns of a node
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	var err error
+	switch f.Protocol {
+	case "udp":
+		if f.packetConn, err = link.ListenPacketInNetns(rootNs, "udp", address); err == nil {
+			f.wg.Add(1)
+			go f.serveUDP()
+		}
+	default:
+		if f.listener, err = link.ListenInNetns(rootNs, "tcp", address); err == nil {
+			f.wg.Add(1)
+			go f.serveTCP()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("unable to forward port %s of node %s: %w", f, f.Node.GetName(), err)
+	}
+
+	return nil
+}
+
+func (f *NetemPortForward) serveTCP() {
+	defer f.wg.Done()
+
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				f.logger.Warnf("Port forward %s: accept error: %v", f, err)
+			}
+			return
+		}
+
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+
+			nodeConn, err := f.dialNode()
+			if err != nil {
+				f.logger.Warnf("Port forward %s: unable to connect to node %s: %v", f, f.Node.GetName(), err)
+				conn.Close()
+				return
+			}
+			if !f.track(conn, nodeConn) {
+				conn.Close()
+				nodeConn.Close()
+				return
+			}
+			defer f.untrack(conn, nodeConn)
+
+			done := make(chan struct{})
+			go func() {
+				io.Copy(nodeConn, conn)
+				if tcpConn, ok := nodeConn.(*net.TCPConn); ok {
+					tcpConn.CloseWrite()
+				}
+				close(done)
+			}()
+			io.Copy(conn, nodeConn)
+			if tcpConn, ok := conn.(*net.TCPConn); ok {
+				tcpConn.CloseWrite()
+			}
+			<-done
+		}()
+	}
+}
+
+// serveUDP relays datagrams between each client address and its own
+// socket in the node, sessions are closed after udpSessionTimeout without
+// answer from the node
+func (f *NetemPortForward) serveUDP() {
+	defer f.wg.Done()
+
+	sessions := make(map[string]net.Conn)
+	var sessionsLock sync.Mutex
+
+	buf := make([]byte, udpBufferSize)
+	for {
+		n, addr, err := f.packetConn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				f.logger.Warnf("Port forward %s: read error: %v", f, err)
+			}
+			return
+		}
+
+		sessionsLock.Lock()
+		nodeConn, found := sessions[addr.String()]
+		sessionsLock.Unlock()
+		if !found {
+			if nodeConn, err = f.dialNode(); err != nil {
+				f.logger.Warnf("Port forward %s: unable to connect to node %s: %v", f, f.Node.GetName(), err)
+				continue
+			}
+			if !f.track(nodeConn) {
+				nodeConn.Close()
+				return
+			}
+
+			sessionsLock.Lock()
+			sessions[addr.String()] = nodeConn
+			sessionsLock.Unlock()
+
+			f.wg.Add(1)
+			go func() {
+				defer f.wg.Done()
+				defer func() {
+					sessionsLock.Lock()
+					delete(sessions, addr.String())
+					sessionsLock.Unlock()
+					f.untrack(nodeConn)
+				}()
+
+				reply := make([]byte, udpBufferSize)
+				for {
+					nodeConn.SetReadDeadline(time.Now().Add(udpSessionTimeout))
+					n, err := nodeConn.Read(reply)
+					if err != nil {
+						return
+					}
+					if _, err := f.packetConn.WriteTo(reply[:n], addr); err != nil {
+						return
+					}
+				}
+			}()
+		}
+
+		if _, err := nodeConn.Write(buf[:n]); err != nil {
+			f.logger.Debugf("Port forward %s: write error: %v", f, err)
+		}
+	}
+}
+
+// Close stops listening on the host port and closes the open connections
+func (f *NetemPortForward) Close() {
+	if f.listener != nil {
+		f.listener.Close()
+	}
+	if f.packetConn != nil {
+		f.packetConn.Close()
+	}
+
+	f.lock.Lock()
+	for conn := range f.conns {
+		conn.Close()
+	}
+	f.conns = nil
+	f.lock.Unlock()
+
+	f.wg.Wait()
+}
+
+func (t *NetemTopologyManager) getPortForward(hostPort int, protocol string) (int, *NetemPortForward) {
+	for idx, pf := range t.portForwards {
+		if pf.HostPort == hostPort && pf.Protocol == protocol {
+			return idx, pf
+		}
+	}
+	return -1, nil
+}
+
+func (t *NetemTopologyManager) startPortForward(node INetemNode, spec string) error {
+	port, err := ParsePortForward(spec)
+	if err != nil {
+		return err
+	}
+	if _, pf := t.getPortForward(port.HostPort, port.Protocol); pf != nil {
+		return fmt.Errorf("host port %d/%s is already forwarded to %s", port.HostPort, port.Protocol, pf.Node.GetName())
+	}
+
+	pf := &NetemPortForward{PortForward: port, Node: node, logger: t.logger}
+	if err := pf.Start(); err != nil {
+		return err
+	}
+	t.portForwards = append(t.portForwards, pf)
+
+	return nil
+}
+
+// startPortForwards forwards the ports declared in the topology. A port
+// which can not be forwarded, ie. already used on the host, does not
+// prevent the topology to run, the errors are returned for each node
+func (t *NetemTopologyManager) startPortForwards() map[string][]string {
+	t.forwardsLock.Lock()
+	defer t.forwardsLock.Unlock()
+
+	messages := make(map[string][]string)
+	for _, node := range t.nodes {
+		for _, spec := range node.Config.Ports {
+			if err := t.startPortForward(node.Instance, spec); err != nil {
+				t.logger.Warnf("Port forward %s: %v", spec, err)
+				name := node.Instance.GetName()
+				messages[name] = append(messages[name], err.Error())
+			}
+		}
+	}
+
+	return messages
+}
+
+func (t *NetemTopologyManager) closePortForwards() {
+	t.forwardsLock.Lock()
+	defer t.forwardsLock.Unlock()
+
+	for _, pf := range t.portForwards {
+		pf.Close()
+	}
+	t.portForwards = make([]*NetemPortForward, 0)
+}
+
+// PortForwardAdd forwards a host port to a node of the running topology,
+// the port is added in the node configuration if sync is true
+func (t *NetemTopologyManager) PortForwardAdd(nodeName, spec string, sync bool) error {
+	node := t.GetNode(nodeName)
+	if node == nil {
+		return fmt.Errorf("node %s not found", nodeName)
+	}
+	nConfig := t.GetNodeConfig(nodeName)
+	if nConfig.Type == "ovs" {
+		return fmt.Errorf("ports can not be forwarded to ovswitch")
+	}
+	if !t.running.Load() {
+		return fmt.Errorf("topology is not running")
+	}
+
+	t.forwardsLock.Lock()
+	err := t.startPortForward(node, spec)
+	t.forwardsLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if sync {
+		nConfig.Ports = append(nConfig.Ports, spec)
+		return t.SynchroniseTopology()
+	}
+	return nil
+}
+
+// PortForwardDel stops forwarding a host port to a node
+func (t *NetemTopologyManager) PortForwardDel(nodeName, spec string, sync bool) error {
+	port, err := ParsePortForward(spec)
+	if err != nil {
+		return err
+	}
+
+	t.forwardsLock.Lock()
+	idx, pf := t.getPortForward(port.HostPort, port.Protocol)
+	if pf == nil || pf.Node.GetName() != nodeName || pf.NodePort != port.NodePort {
+		t.forwardsLock.Unlock()
+		return fmt.Errorf("port %s is not forwarded to node %s", port, nodeName)
+	}
+	t.portForwards = slices.Delete(t.portForwards, idx, idx+1)
+	t.forwardsLock.Unlock()
+	pf.Close()
+
+	if sync {
+		nConfig := t.GetNodeConfig(nodeName)
+		nConfig.Ports = slices.DeleteFunc(nConfig.Ports, func(s string) bool {
+			p, err := ParsePortForward(s)
+			return err == nil && p == port
+		})
+		return t.SynchroniseTopology()
+	}
+	return nil
+}
+
+func (t *NetemTopologyManager) GetPortForwardsStatus() []*proto.StatusResponse_PortForwardStatus {
+	t.forwardsLock.Lock()
+	defer t.forwardsLock.Unlock()
+
+	var forwards []*proto.StatusResponse_PortForwardStatus
+	for _, pf := range t.portForwards {
+		forwards = append(forwards, &proto.StatusResponse_PortForwardStatus{
+			Node: pf.Node.GetName(),
+			Port: pf.String(),
+		})
+	}
+
+	return forwards
+}
@@ -0,0 +1,331 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mroy31/gonetem/internal/link"
+	"github.com/mroy31/gonetem/internal/proto"
+)
+
+var (
+	projectPeerRE = regexp.MustCompile(`^([\w\-\.]+)/(\w+)\.(\d+)$`)
+
+	// project links are shared by two topologies
+	projectLinksLock sync.Mutex
+)
+
+// IsProjectPeer returns true for a node interface of another project open
+// on the same server, declared in links with the format
+// <project>/<node>.<ifIndex>
+func IsProjectPeer(peer string) bool {
+	return !IsRemotePeer(peer) && strings.Contains(peer, "/")
+}
+
+// NetemProjectLink is a link between a node of the project declaring it
+// (Owner) and a node of another project. It is connected once both
+// projects are running and deleted when one of them is closed
+type NetemProjectLink struct {
+	Link    *NetemLink
+	Owner   *NetemTopologyManager
+	Project string
+	Peer    string
+
+	// topology of the other project, nil when the link is not connected
+	peerTopo *NetemTopologyManager
+}
+
+func (pl *NetemProjectLink) IsConnected() bool {
+	return pl.peerTopo != nil
+}
+
+// GetPeers returns the two ends of the link, seen from the topology t
+func (pl *NetemProjectLink) GetPeers(t *NetemTopologyManager) (string, string) {
+	if pl.Owner == t {
+		return pl.Link.Config.Peer1, pl.Link.Config.Peer2
+	}
+	return pl.Peer, fmt.Sprintf("%s/%s", pl.Owner.projectName(), pl.Link.Config.Peer1)
+}
+
+func checkProjectLink(lConfig LinkConfig) error {
+	if IsProjectPeer(lConfig.Peer1) {
+		return fmt.Errorf("link %s-%s: project peer has to be set in peer2", lConfig.Peer1, lConfig.Peer2)
+	}
+	if !projectPeerRE.MatchString(lConfig.Peer2) {
+		return fmt.Errorf(
+			"link: invalid format for project peer '%s' (<project>/<node>.<ifIndex> required)", lConfig.Peer2)
+	}
+
+	return nil
+}
+
+// projectName returns the name of the open project using the topology
+func (t *NetemTopologyManager) projectName() string {
+	if prj := ProjectGetOne(t.prjID); prj != nil {
+		return prj.Name
+	}
+	return t.prjID
+}
+
+func (t *NetemTopologyManager) newProjectLink(lConfig LinkConfig) (*NetemProjectLink, error) {
+	groups := projectPeerRE.FindStringSubmatch(lConfig.Peer2)
+	if len(groups) != 4 {
+		return nil, fmt.Errorf("link: invalid format for project peer '%s'", lConfig.Peer2)
+	}
+
+	if err := lConfig.SetProfile(lConfig.Profile, t.profiles); err != nil {
+		return nil, err
+	}
+
+	peer1 := strings.Split(lConfig.Peer1, ".")
+	peer1Idx, _ := strconv.Atoi(peer1[1])
+	return &NetemProjectLink{
+		Link: &NetemLink{
+			Peer1:  NetemLinkPeer{Node: t.GetNode(peer1[0]), IfIndex: peer1Idx},
+			Config: lConfig,
+		},
+		Owner:   t,
+		Project: groups[1],
+		Peer:    groups[2] + "." + groups[3],
+	}, nil
+}
+
+// connectProjectLinks connects the links declared by the topology, and
+// the links of other running projects targeting it. Links whose other
+// project is not open or not running are connected later
+func (t *NetemTopologyManager) connectProjectLinks() error {
+	projectLinksLock.Lock()
+	defer projectLinksLock.Unlock()
+
+	for _, pl := range t.projectLinks {
+		if pl.Owner != t || pl.IsConnected() {
+			continue
+		}
+
+		prj, err := ProjectGetByName(pl.Project)
+		if err != nil {
+			return fmt.Errorf("link %s-%s: %w", pl.Link.Config.Peer1, pl.Link.Config.Peer2, err)
+		}
+		if prj == nil || !prj.Topology.IsRunning() {
+			t.logger.Infof("Link %s-%s: project %s not running, not connected", pl.Link.Config.Peer1, pl.Link.Config.Peer2, pl.Project)
+			continue
+		}
+		if err := t.connectProjectLink(pl, prj.Topology); err != nil {
+			return err
+		}
+	}
+
+	// links of other projects can not target this one if its name is
+	// shared with another open project
+	name := t.projectName()
+	if _, err := ProjectGetByName(name); err != nil {
+		t.logger.Warnf("Links of other projects not connected: %v", err)
+		return nil
+	}
+	for _, prj := range ProjectGetMany() {
+		if prj.Topology == t || !prj.Topology.IsRunning() {
+			continue
+		}
+
+		for _, pl := range prj.Topology.projectLinks {
+			if pl.Owner != prj.Topology || pl.IsConnected() || pl.Project != name {
+				continue
+			}
+			if err := pl.Owner.connectProjectLink(pl, t); err != nil {
+				t.logger.Warnf("Unable to connect link %s/%s-%s: %v", prj.Name, pl.Link.Config.Peer1, pl.Peer, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// connectProjectLink creates the link between the owner topology t and
+// the topology peerTopo of the other project
+func (t *NetemTopologyManager) connectProjectLink(pl *NetemProjectLink, peerTopo *NetemTopologyManager) error {
+	peer := strings.Split(pl.Peer, ".")
+	peerIdx, _ := strconv.Atoi(peer[1])
+	node := peerTopo.GetNode(peer[0])
+	if node == nil {
+		return fmt.Errorf("link %s-%s: node %s not found in project %s", pl.Link.Config.Peer1, pl.Link.Config.Peer2, peer[0], pl.Project)
+	}
+	if _, found := node.GetInterfacesState()[node.GetInterfaceName(peerIdx)]; found {
+		return fmt.Errorf("link %s-%s: interface %s is already used", pl.Link.Config.Peer1, pl.Link.Config.Peer2, pl.Peer)
+	}
+
+	pl.Link.Peer2 = NetemLinkPeer{Node: node, IfIndex: peerIdx}
+	if err := t.setupProjectLink(pl, peerTopo); err != nil {
+		pl.Link.Peer2 = NetemLinkPeer{}
+		return err
+	}
+
+	pl.peerTopo = peerTopo
+	peerTopo.projectLinks = append(peerTopo.projectLinks, pl)
+	return nil
+}
+
+// setupProjectLink creates the veth of the link, interfaces of a running
+// topology are configured here, the others when the topology runs. The
+// lock of the other topology can not be taken here, its events watcher
+// may hold it while waiting for projectLinksLock: its running state is
+// read once, and only its declared interface configuration is used
+func (t *NetemTopologyManager) setupProjectLink(pl *NetemProjectLink, peerTopo *NetemTopologyManager) error {
+	l := pl.Link
+	running, peerRunning := t.IsRunning(), peerTopo.IsRunning()
+	peer1Netns, err := l.Peer1.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peer1Netns.Close()
+
+	peer2Netns, err := l.Peer2.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peer2Netns.Close()
+
+	peer1IfName := l.Peer1.Node.GetInterfaceName(l.Peer1.IfIndex)
+	peer2IfName := l.Peer2.Node.GetInterfaceName(l.Peer2.IfIndex)
+	_, err = link.CreateVethLink(peer1IfName, peer1Netns, peer2IfName, peer2Netns, link.VethOptions{
+		MTU:     l.Config.Mtu,
+		MAC:     t.getInterfaceMAC(l.Peer1.Node, l.Peer1.IfIndex),
+		PeerMAC: peerTopo.getInterfaceMAC(l.Peer2.Node, l.Peer2.IfIndex),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create link %s-%s: %v", l.Config.Peer1, l.Config.Peer2, err)
+	}
+
+	// qdiscs are created again with the veth
+	l.HasPeer1Netem, l.HasPeer2Netem = false, false
+	l.HasPeer1Tbf, l.HasPeer2Tbf = false, false
+	if err := l.SetPeer1Netem(peer1IfName, peer1Netns); err != nil {
+		return err
+	}
+	if err := l.SetPeer2Netem(peer2IfName, peer2Netns); err != nil {
+		return err
+	}
+	if err := l.SetPeer1TBF(peer1IfName, peer1Netns); err != nil {
+		return err
+	}
+	if err := l.SetPeer2TBF(peer2IfName, peer2Netns); err != nil {
+		return err
+	}
+	if err := l.SetAlias(peer1IfName, peer1Netns, peer2IfName, peer2Netns); err != nil {
+		return err
+	}
+
+	if err := link.SetInterfaceState(peer1IfName, peer1Netns, link.IFSTATE_UP); err != nil {
+		return err
+	}
+	if err := link.SetInterfaceState(peer2IfName, peer2Netns, link.IFSTATE_UP); err != nil {
+		return err
+	}
+
+	if err := l.Peer1.Node.AttachInterface(peer1IfName, l.Peer1.IfIndex, running); err != nil {
+		return err
+	}
+	if err := l.Peer2.Node.AttachInterface(peer2IfName, l.Peer2.IfIndex, peerRunning); err != nil {
+		return err
+	}
+
+	// declared interfaces configuration is applied by each topology
+	if running {
+		if err := t.configureNodeNetwork(l.Peer1.Node); err != nil {
+			return err
+		}
+	}
+	if peerRunning {
+		return peerTopo.configureNodeNetwork(l.Peer2.Node)
+	}
+
+	return nil
+}
+
+// disconnectProjectLink deletes the veth of the link and detaches its
+// interfaces from the nodes of the two projects
+func disconnectProjectLink(pl *NetemProjectLink) error {
+	if !pl.IsConnected() {
+		return nil
+	}
+
+	l := pl.Link
+	peerTopo := pl.peerTopo
+	pl.peerTopo = nil
+	peerTopo.projectLinks = slices.DeleteFunc(peerTopo.projectLinks, func(p *NetemProjectLink) bool {
+		return p == pl
+	})
+	defer func() { l.Peer2 = NetemLinkPeer{} }()
+
+	peer1Netns, err := l.Peer1.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peer1Netns.Close()
+
+	peer1IfName := l.Peer1.Node.GetInterfaceName(l.Peer1.IfIndex)
+	peer2IfName := l.Peer2.Node.GetInterfaceName(l.Peer2.IfIndex)
+	if link.IsLinkExist(peer1IfName, peer1Netns) {
+		if err := link.DeleteLink(peer1IfName, peer1Netns); err != nil {
+			return err
+		}
+	}
+
+	if err := l.Peer1.Node.DetachInterface(peer1IfName); err != nil {
+		return err
+	}
+	return l.Peer2.Node.DetachInterface(peer2IfName)
+}
+
+// GetProjectLinksStatus returns the links of the topology with other
+// projects, declared or not in this topology
+func (t *NetemTopologyManager) GetProjectLinksStatus() []*proto.StatusResponse_ProjectLinkStatus {
+	projectLinksLock.Lock()
+	defer projectLinksLock.Unlock()
+
+	var links []*proto.StatusResponse_ProjectLinkStatus
+	for _, pl := range t.projectLinks {
+		peer1, peer2 := pl.GetPeers(t)
+		links = append(links, &proto.StatusResponse_ProjectLinkStatus{
+			Peer1:     peer1,
+			Peer2:     peer2,
+			Connected: pl.IsConnected(),
+		})
+	}
+
+	return links
+}
+
+// restoreProjectLinks creates again the project links of a node
+func (t *NetemTopologyManager) restoreProjectLinks(node INetemNode) error {
+	projectLinksLock.Lock()
+	defer projectLinksLock.Unlock()
+
+	for _, pl := range t.projectLinks {
+		if !pl.IsConnected() || (pl.Link.Peer1.Node != node && pl.Link.Peer2.Node != node) {
+			continue
+		}
+		if err := pl.Owner.setupProjectLink(pl, pl.peerTopo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeProjectLinks disconnects all the links of the topology, declared
+// links of other projects are connected again when this project runs
+func (t *NetemTopologyManager) closeProjectLinks() {
+	projectLinksLock.Lock()
+	defer projectLinksLock.Unlock()
+
+	for _, pl := range slices.Clone(t.projectLinks) {
+		if err := disconnectProjectLink(pl); err != nil {
+			t.logger.Warnf("Error when deleting link %s-%s: %v", pl.Link.Config.Peer1, pl.Link.Config.Peer2, err)
+		}
+	}
+	t.projectLinks = make([]*NetemProjectLink, 0)
+}
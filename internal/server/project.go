@@ -61,6 +61,23 @@ func ProjectGetOne(prjID string) *NetemProject {
 	return nil
 }
 
+// ProjectGetByName returns the open project named prjName, or nil if
+// there is none. Several projects can be open with the same name, an
+// error is returned in this case since the project is ambiguous
+func ProjectGetByName(prjName string) (*NetemProject, error) {
+	var project *NetemProject
+	for _, prj := range openProjects {
+		if prj.Name != prjName {
+			continue
+		}
+		if project != nil {
+			return nil, fmt.Errorf("several open projects are named %s", prjName)
+		}
+		project = prj
+	}
+	return project, nil
+}
+
 func ProjectOpen(prjId, name string, data []byte) (*NetemProject, error) {
 	// create temp directory for the project
 	dir, err := os.MkdirTemp(options.ServerConfig.Workdir, "gonetem-"+prjId+"-")
@@ -0,0 +1,510 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mroy31/gonetem/internal/link"
+	"github.com/mroy31/gonetem/internal/options"
+	"github.com/mroy31/gonetem/internal/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	remotePrefix  = "remote://"
+	remoteTimeout = 30 * time.Second
+	minVni        = 4096
+	maxVni        = 1<<24 - 1
+)
+
+var (
+	remotePeerRE = regexp.MustCompile(`^remote://([\w\-\.]+)/([\w\-\.]+)/(\w+\.\d+)$`)
+
+	// VNIs are allocated for all projects, tunnels of the server share
+	// the same UDP socket
+	vniLock   sync.Mutex
+	vnis      = make(map[int]bool)
+	vniCursor = minVni
+)
+
+// RemotePeer is a node interface of another gonetem server, declared in
+// links with the format remote://<server>/<project>/<node>.<ifIndex>
+type RemotePeer struct {
+	Server  string
+	Project string
+	Peer    string
+}
+
+func (p RemotePeer) String() string {
+	return fmt.Sprintf("%s%s/%s/%s", remotePrefix, p.Server, p.Project, p.Peer)
+}
+
+func IsRemotePeer(peer string) bool {
+	return strings.HasPrefix(peer, remotePrefix)
+}
+
+func ParseRemotePeer(peer string) (RemotePeer, error) {
+	groups := remotePeerRE.FindStringSubmatch(peer)
+	if len(groups) != 4 {
+		return RemotePeer{}, fmt.Errorf(
+			"link: invalid format for remote peer '%s' (remote://<server>/<project>/<node>.<ifIndex> required)", peer)
+	}
+
+	return RemotePeer{Server: groups[1], Project: groups[2], Peer: groups[3]}, nil
+}
+
+// NetemRemoteLink is a VXLAN tunnel between a local node interface and a
+// node interface of another server. Accepted links are the ones
+// initiated by the other server, they are not saved in the topology
+type NetemRemoteLink struct {
+	Peer     NetemLinkPeer
+	Config   LinkConfig
+	Remote   RemotePeer
+	Accepted bool
+
+	Vni        int
+	RemoteVtep net.IP
+	RemotePort int
+	RemoteVni  int
+}
+
+func checkRemoteLink(lConfig LinkConfig) error {
+	if IsRemotePeer(lConfig.Peer1) {
+		return fmt.Errorf("link %s-%s: remote peer has to be set in peer2", lConfig.Peer1, lConfig.Peer2)
+	}
+
+	remote, err := ParseRemotePeer(lConfig.Peer2)
+	if err != nil {
+		return err
+	}
+	if _, found := options.ServerConfig.Remote.Servers[remote.Server]; !found {
+		return fmt.Errorf("link %s-%s: server '%s' is not declared in server config", lConfig.Peer1, lConfig.Peer2, remote.Server)
+	}
+	if net.ParseIP(options.ServerConfig.Remote.Vtep) == nil {
+		return fmt.Errorf("link %s-%s: remote.vtep is not valid in server config", lConfig.Peer1, lConfig.Peer2)
+	}
+
+	return nil
+}
+
+func getRemotePort() int {
+	if options.ServerConfig.Remote.Port == 0 {
+		return link.DefaultVxlanPort
+	}
+	return options.ServerConfig.Remote.Port
+}
+
+func allocateVni() (int, error) {
+	vniLock.Lock()
+	defer vniLock.Unlock()
+
+	for i := 0; i <= maxVni-minVni; i++ {
+		vni := vniCursor
+		vniCursor++
+		if vniCursor > maxVni {
+			vniCursor = minVni
+		}
+
+		if !vnis[vni] {
+			vnis[vni] = true
+			return vni, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no VNI available")
+}
+
+func releaseVni(vni int) {
+	vniLock.Lock()
+	defer vniLock.Unlock()
+
+	delete(vnis, vni)
+}
+
+func newRemoteClient(server string) (*grpc.ClientConn, proto.NetemClient, error) {
+	address, found := options.ServerConfig.Remote.Servers[server]
+	if !found {
+		return nil, nil, fmt.Errorf("server '%s' is not declared in server config", server)
+	}
+
+	creds := insecure.NewCredentials()
+	if options.ServerConfig.Tls.Enabled {
+		var err error
+		if creds, err = options.LoadRemoteTLSCredentials(); err != nil {
+			return nil, nil, fmt.Errorf("cannot load TLS credentials: %w", err)
+		}
+	}
+
+	// the calling thread may have been left in the netns of a node
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		rootNs := link.GetRootNetns()
+		defer rootNs.Close()
+
+		timeout := remoteTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+		return link.DialInNetns(rootNs, "tcp", addr, timeout)
+	}
+
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(dialer),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to connect to server %s: %w", server, err)
+	}
+	return conn, proto.NewNetemClient(conn), nil
+}
+
+func (t *NetemTopologyManager) newRemoteLink(lConfig LinkConfig) (*NetemRemoteLink, error) {
+	remote, err := ParseRemotePeer(lConfig.Peer2)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lConfig.SetProfile(lConfig.Profile, t.profiles); err != nil {
+		return nil, err
+	}
+
+	peer := strings.Split(lConfig.Peer1, ".")
+	peerIdx, _ := strconv.Atoi(peer[1])
+	return &NetemRemoteLink{
+		Peer:   NetemLinkPeer{Node: t.GetNode(peer[0]), IfIndex: peerIdx},
+		Config: lConfig,
+		Remote: remote,
+	}, nil
+}
+
+// getRemoteLinks returns a copy of the remote links, to iterate over
+// them without holding the lock
+func (t *NetemTopologyManager) getRemoteLinks() []*NetemRemoteLink {
+	t.remoteLinksLock.Lock()
+	defer t.remoteLinksLock.Unlock()
+
+	return slices.Clone(t.remoteLinks)
+}
+
+// connectRemoteLinks connects the remote links declared in the topology.
+// A link which can not be connected, for example because the remote
+// server is unreachable, does not prevent the topology to run: the
+// error is returned in the messages of its node
+func (t *NetemTopologyManager) connectRemoteLinks() map[string][]string {
+	messages := make(map[string][]string)
+	for _, rl := range t.getRemoteLinks() {
+		if err := t.connectRemoteLink(rl); err != nil {
+			t.logger.Warnf("Remote link not connected: %v", err)
+			name := rl.Peer.Node.GetName()
+			messages[name] = append(messages[name], fmt.Sprintf(
+				"link %s-%s not connected: %v", rl.Config.Peer1, rl.Config.Peer2, err))
+		}
+	}
+
+	return messages
+}
+
+// connectRemoteLink allocates a VNI and asks the remote server to create
+// its end of the tunnel before creating the local one
+func (t *NetemTopologyManager) connectRemoteLink(rl *NetemRemoteLink) error {
+	vni, err := allocateVni()
+	if err != nil {
+		return err
+	}
+	rl.Vni = vni
+
+	if err := t.requestRemoteLink(rl); err != nil {
+		releaseVni(vni)
+		rl.Vni = 0
+		return err
+	}
+
+	return t.setupRemoteLink(rl, false)
+}
+
+// requestRemoteLink asks the remote server to create its end of the
+// tunnel, and stores the parameters of this end in rl
+func (t *NetemTopologyManager) requestRemoteLink(rl *NetemRemoteLink) error {
+	conn, client, err := newRemoteClient(rl.Remote.Server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+
+	response, err := client.LinkRemoteConnect(ctx, &proto.RemoteLinkRequest{
+		PrjName: rl.Remote.Project,
+		Peer:    rl.Remote.Peer,
+		Vtep:    options.ServerConfig.Remote.Vtep,
+		Port:    int32(getRemotePort()),
+		Vni:     uint32(rl.Vni),
+		Mtu:     int32(rl.Config.Mtu),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to connect remote link %s: %w", rl.Remote, err)
+	}
+	if response.GetStatus().GetCode() == proto.StatusCode_ERROR {
+		return fmt.Errorf("unable to connect remote link %s: %s", rl.Remote, response.GetStatus().GetError())
+	}
+
+	rl.RemoteVtep = net.ParseIP(response.GetVtep())
+	if rl.RemoteVtep == nil {
+		return fmt.Errorf("remote link %s: vtep '%s' is not valid", rl.Remote, response.GetVtep())
+	}
+	rl.RemotePort = int(response.GetPort())
+	rl.RemoteVni = int(response.GetVni())
+
+	return nil
+}
+
+// setupRemoteLink creates the local end of the tunnel, QoS is applied on
+// the traffic sent to the remote server
+func (t *NetemTopologyManager) setupRemoteLink(rl *NetemRemoteLink, configure bool) error {
+	rootNs := link.GetRootNetns()
+	defer rootNs.Close()
+
+	peerNetns, err := rl.Peer.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peerNetns.Close()
+
+	peerIfName := rl.Peer.Node.GetInterfaceName(rl.Peer.IfIndex)
+	err = link.CreateVxlan(peerIfName, link.VxlanOptions{
+		Vni:        rl.Vni,
+		Local:      net.ParseIP(options.ServerConfig.Remote.Vtep),
+		Port:       getRemotePort(),
+		Remote:     rl.RemoteVtep,
+		RemoteVni:  rl.RemoteVni,
+		RemotePort: rl.RemotePort,
+		MTU:        rl.Config.Mtu,
+		MAC:        t.getInterfaceMAC(rl.Peer.Node, rl.Peer.IfIndex),
+	}, rootNs, peerNetns)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to create link %s.%d-%s: %v",
+			rl.Peer.Node.GetName(), rl.Peer.IfIndex, rl.Remote, err,
+		)
+	}
+
+	if !rl.Accepted {
+		qos := rl.Config.GetPeer1QoS()
+		if qos.Delay > 0 || qos.Loss > 0 || qos.Jitter > 0 {
+			if err := link.Netem(peerIfName, peerNetns, qos.Delay, qos.Jitter, qos.Loss, false); err != nil {
+				return err
+			}
+		}
+		if qos.Rate > 0 {
+			if err := link.CreateTbf(peerIfName, peerNetns, qos.Delay+qos.Jitter, qos.Rate, qos.Buffer, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if rl.Config.Description != "" {
+		if err := link.SetInterfaceAlias(peerIfName, peerNetns, rl.Config.Description); err != nil {
+			return err
+		}
+	}
+
+	if err := rl.Peer.Node.AttachInterface(peerIfName, rl.Peer.IfIndex, configure); err != nil {
+		return err
+	}
+	if configure {
+		return t.configureNodeNetwork(rl.Peer.Node)
+	}
+
+	return nil
+}
+
+// AcceptRemoteLink creates the end of a tunnel initiated by another
+// server. The interface stays attached to the node when the other server
+// disconnects, it can be connected again
+func (t *NetemTopologyManager) AcceptRemoteLink(request *proto.RemoteLinkRequest) (*NetemRemoteLink, error) {
+	t.remoteLinksLock.Lock()
+	defer t.remoteLinksLock.Unlock()
+
+	if !t.running.Load() {
+		return nil, fmt.Errorf("topology is not running")
+	}
+
+	peer := strings.Split(request.GetPeer(), ".")
+	if len(peer) != 2 {
+		return nil, fmt.Errorf("peer '%s' is not valid", request.GetPeer())
+	}
+	peerIdx, err := strconv.Atoi(peer[1])
+	if err != nil {
+		return nil, fmt.Errorf("peer '%s' is not valid", request.GetPeer())
+	}
+	node := t.GetNode(peer[0])
+	if node == nil {
+		return nil, fmt.Errorf("node %s not found in the topology", peer[0])
+	}
+
+	remoteVtep := net.ParseIP(request.GetVtep())
+	if remoteVtep == nil {
+		return nil, fmt.Errorf("vtep '%s' is not valid", request.GetVtep())
+	}
+	if net.ParseIP(options.ServerConfig.Remote.Vtep) == nil {
+		return nil, fmt.Errorf("remote.vtep is not valid in server config")
+	}
+
+	ifName := node.GetInterfaceName(peerIdx)
+	idx := t.getAcceptedRemoteLink(node, peerIdx)
+	if idx != -1 {
+		// previous connection, the interface is created again
+		rl := t.remoteLinks[idx]
+		if err := t.deleteRemoteLink(rl); err != nil {
+			return nil, err
+		}
+		t.remoteLinks = append(t.remoteLinks[:idx], t.remoteLinks[idx+1:]...)
+	} else if _, found := node.GetInterfacesState()[ifName]; found {
+		return nil, fmt.Errorf("interface %s.%d is already used", node.GetName(), peerIdx)
+	}
+
+	vni, err := allocateVni()
+	if err != nil {
+		return nil, err
+	}
+	rl := &NetemRemoteLink{
+		Peer:       NetemLinkPeer{Node: node, IfIndex: peerIdx},
+		Config:     LinkConfig{Peer1: request.GetPeer(), Mtu: int(request.GetMtu())},
+		Accepted:   true,
+		Vni:        vni,
+		RemoteVtep: remoteVtep,
+		RemotePort: int(request.GetPort()),
+		RemoteVni:  int(request.GetVni()),
+	}
+	if err := t.setupRemoteLink(rl, true); err != nil {
+		releaseVni(vni)
+		return nil, err
+	}
+
+	t.remoteLinks = append(t.remoteLinks, rl)
+	return rl, nil
+}
+
+// DisconnectRemoteLink sets down the end of a tunnel initiated by another
+// server, when it closes the link
+func (t *NetemTopologyManager) DisconnectRemoteLink(request *proto.RemoteLinkRequest) error {
+	t.remoteLinksLock.Lock()
+	defer t.remoteLinksLock.Unlock()
+
+	peer := strings.Split(request.GetPeer(), ".")
+	if len(peer) != 2 {
+		return fmt.Errorf("peer '%s' is not valid", request.GetPeer())
+	}
+	peerIdx, _ := strconv.Atoi(peer[1])
+	node := t.GetNode(peer[0])
+	if node == nil {
+		return fmt.Errorf("node %s not found in the topology", peer[0])
+	}
+
+	idx := t.getAcceptedRemoteLink(node, peerIdx)
+	if idx == -1 || t.remoteLinks[idx].RemoteVni != int(request.GetVni()) {
+		return fmt.Errorf("remote link %s not found", request.GetPeer())
+	}
+
+	ns, err := node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	return link.SetInterfaceState(node.GetInterfaceName(peerIdx), ns, link.IFSTATE_DOWN)
+}
+
+func (t *NetemTopologyManager) getAcceptedRemoteLink(node INetemNode, ifIndex int) int {
+	for idx, rl := range t.remoteLinks {
+		if rl.Accepted && rl.Peer.Node == node && rl.Peer.IfIndex == ifIndex {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (t *NetemTopologyManager) deleteRemoteLink(rl *NetemRemoteLink) error {
+	ns, err := rl.Peer.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	ifName := rl.Peer.Node.GetInterfaceName(rl.Peer.IfIndex)
+	if link.IsLinkExist(ifName, ns) {
+		if err := link.DeleteLink(ifName, ns); err != nil {
+			return err
+		}
+	}
+	releaseVni(rl.Vni)
+
+	return nil
+}
+
+// restoreRemoteLinks creates again the tunnels of a node, the remote end
+// is not modified
+func (t *NetemTopologyManager) restoreRemoteLinks(node INetemNode) error {
+	t.remoteLinksLock.Lock()
+	defer t.remoteLinksLock.Unlock()
+
+	for _, rl := range t.remoteLinks {
+		if rl.Peer.Node == node && rl.RemoteVtep != nil {
+			if err := t.setupRemoteLink(rl, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// closeRemoteLinks releases the VNIs of the project and notifies remote
+// servers for the links initiated by this project
+func (t *NetemTopologyManager) closeRemoteLinks() {
+	for _, rl := range t.getRemoteLinks() {
+		if rl.Vni != 0 {
+			releaseVni(rl.Vni)
+		}
+		if rl.Accepted || rl.RemoteVtep == nil {
+			continue
+		}
+
+		err := func() error {
+			conn, client, err := newRemoteClient(rl.Remote.Server)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+			defer cancel()
+
+			response, err := client.LinkRemoteDisconnect(ctx, &proto.RemoteLinkRequest{
+				PrjName: rl.Remote.Project,
+				Peer:    rl.Remote.Peer,
+				Vtep:    options.ServerConfig.Remote.Vtep,
+				Port:    int32(getRemotePort()),
+				Vni:     uint32(rl.Vni),
+			})
+			if err != nil {
+				return err
+			}
+			if response.GetStatus().GetCode() == proto.StatusCode_ERROR {
+				return fmt.Errorf("%s", response.GetStatus().GetError())
+			}
+			return nil
+		}()
+		if err != nil {
+			t.logger.Warnf("Error when disconnecting remote link %s: %v", rl.Remote, err)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/mroy31/gonetem/internal/link"
+	"github.com/mroy31/gonetem/internal/options"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+type NetemSegment struct {
+	Name   string
+	BrName string
+	Peers  []NetemLinkPeer
+	Config SegmentConfig
+}
+
+func checkSegmentConfig(name string, sConfig SegmentConfig, bridges []string) error {
+	if isEntryExist(bridges, name) {
+		return fmt.Errorf("segment '%s': a bridge or a segment already exists with this name", name)
+	}
+	if !nameRE.MatchString(name) {
+		return fmt.Errorf("segment: '%s' name field is not valid", name)
+	}
+	if err := checkMtu(sConfig.Mtu); err != nil {
+		return fmt.Errorf("segment %s: %w", name, err)
+	}
+	if len(sConfig.Members) < 2 {
+		return fmt.Errorf("segment %s: at least 2 members are required", name)
+	}
+
+	return nil
+}
+
+func (t *NetemTopologyManager) getSegmentsNetnsName() string {
+	return fmt.Sprintf("%s%s.seg", options.NETEM_ID, t.prjID)
+}
+
+// getSegmentsNetns returns the netns of the segments, it is created if
+// it does not exist yet
+func (t *NetemTopologyManager) getSegmentsNetns() (netns.NsHandle, error) {
+	ns, err := netns.GetFromName(t.getSegmentsNetnsName())
+	if err == nil {
+		return ns, nil
+	}
+
+	ns, err = link.CreateNetns(t.getSegmentsNetnsName())
+	if err != nil {
+		return netns.NsHandle(0), fmt.Errorf("unable to create segments netns: %w", err)
+	}
+	return ns, nil
+}
+
+func (t *NetemTopologyManager) getSegmentPortName(peer NetemLinkPeer) string {
+	return fmt.Sprintf("%s.%d", peer.Node.GetShortName(), peer.IfIndex)
+}
+
+func (t *NetemTopologyManager) setupSegment(seg *NetemSegment) error {
+	segNs, err := t.getSegmentsNetns()
+	if err != nil {
+		return err
+	}
+	defer segNs.Close()
+
+	brId, err := link.CreateBridge(seg.BrName, segNs)
+	if err != nil {
+		return err
+	}
+
+	if seg.Config.Description != "" {
+		if err := link.SetInterfaceAlias(seg.BrName, segNs, seg.Config.Description); err != nil {
+			return err
+		}
+	}
+
+	for idx, peer := range seg.Peers {
+		if err := t.setupSegmentPeer(seg, brId, peer, seg.Config.Members[idx].QoSConfig, segNs, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *NetemTopologyManager) setupSegmentPeer(seg *NetemSegment, brId *netlink.Bridge, peer NetemLinkPeer, qos QoSConfig, segNs netns.NsHandle, configure bool) error {
+	peerNetns, err := peer.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peerNetns.Close()
+
+	portName := t.getSegmentPortName(peer)
+	peerIfName := peer.Node.GetInterfaceName(peer.IfIndex)
+	if _, err := link.CreateVethLink(
+		portName, segNs,
+		peerIfName, peerNetns,
+		link.VethOptions{
+			MTU:     seg.Config.Mtu,
+			MAC:     link.GenerateMAC(fmt.Sprintf("%s/%s/%s.%d", t.projectName(), seg.Name, peer.Node.GetName(), peer.IfIndex)),
+			PeerMAC: t.getInterfaceMAC(peer.Node, peer.IfIndex),
+		},
+	); err != nil {
+		return fmt.Errorf(
+			"unable to create link %s-%s.%d: %v",
+			seg.Name, peer.Node.GetName(), peer.IfIndex, err,
+		)
+	}
+
+	if seg.Config.Description != "" {
+		if err := link.SetInterfaceAlias(peerIfName, peerNetns, seg.Config.Description); err != nil {
+			return err
+		}
+	}
+
+	// apply QoS on the traffic sent to the member
+	if qos.Delay > 0 || qos.Loss > 0 || qos.Jitter > 0 {
+		if err := link.Netem(portName, segNs, qos.Delay, qos.Jitter, qos.Loss, false); err != nil {
+			return err
+		}
+	}
+	if qos.Rate > 0 {
+		buffer := qos.Buffer
+		if buffer == 0.0 {
+			buffer = 1.0
+		}
+		if err := link.CreateTbf(portName, segNs, qos.Delay+qos.Jitter, qos.Rate, buffer, false); err != nil {
+			return err
+		}
+	}
+
+	if err := link.SetInterfaceState(portName, segNs, link.IFSTATE_UP); err != nil {
+		return err
+	}
+	if err := link.AttachToBridge(brId, portName, segNs); err != nil {
+		return err
+	}
+
+	if err := peer.Node.AttachInterface(peerIfName, peer.IfIndex, configure); err != nil {
+		return err
+	}
+	if configure {
+		return t.configureNodeNetwork(peer.Node)
+	}
+
+	return nil
+}
+
+// restoreSegmentPeers creates again the segment ports of a node
+func (t *NetemTopologyManager) restoreSegmentPeers(node INetemNode) error {
+	if len(t.segments) == 0 {
+		return nil
+	}
+
+	segNs, err := t.getSegmentsNetns()
+	if err != nil {
+		return err
+	}
+	defer segNs.Close()
+
+	for _, seg := range t.segments {
+		for idx, peer := range seg.Peers {
+			if peer.Node != node {
+				continue
+			}
+
+			brId, err := link.GetBridge(seg.BrName, segNs)
+			if err != nil {
+				return err
+			}
+			if err := t.setupSegmentPeer(seg, brId, peer, seg.Config.Members[idx].QoSConfig, segNs, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// detachSegmentPeers deletes the segment ports of a crashed node. They
+// are normally destroyed with their veth peer, a remaining port would
+// prevent to create it again when the node restarts
+func (t *NetemTopologyManager) detachSegmentPeers(node INetemNode) {
+	if len(t.segments) == 0 {
+		return
+	}
+
+	segNs, err := t.getSegmentsNetns()
+	if err != nil {
+		t.logger.Warnf("Unable to get segments netns: %v", err)
+		return
+	}
+	defer segNs.Close()
+
+	for _, seg := range t.segments {
+		for _, peer := range seg.Peers {
+			if peer.Node != node {
+				continue
+			}
+
+			portName := t.getSegmentPortName(peer)
+			if !link.IsLinkExist(portName, segNs) {
+				continue
+			}
+			if err := link.DeleteLink(portName, segNs); err != nil {
+				t.logger.Warnf("Unable to delete port %s of segment %s: %v", portName, seg.Name, err)
+			}
+		}
+	}
+}
+
+// closeSegments deletes the netns of the segments, with the bridges
+// and the veths of the members
+func (t *NetemTopologyManager) closeSegments() {
+	if len(t.segments) == 0 {
+		return
+	}
+
+	if ns, err := netns.GetFromName(t.getSegmentsNetnsName()); err == nil {
+		ns.Close()
+		if err := link.DeleteNetns(t.getSegmentsNetnsName()); err != nil {
+			t.logger.Warnf("Error when deleting segments netns: %v", err)
+		}
+	}
+}
@@ -4,9 +4,11 @@ import (
 	context "context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path"
 	"regexp"
+	"strconv"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/moby/term"
@@ -22,17 +24,19 @@ import (
 func getLinkConfigFromRequest(request *proto.LinkRequest) LinkConfig {
 	rLink := request.GetLink()
 	return LinkConfig{
-		Peer1: rLink.GetPeer1(),
-		Peer2: rLink.GetPeer2(),
+		Peer1:       rLink.GetPeer1(),
+		Peer2:       rLink.GetPeer2(),
+		Profile:     rLink.GetProfile(),
+		Description: rLink.GetDescription(),
 		Peer1QoS: QoSConfig{
-			Loss:   float64(rLink.Peer1Qos.Loss),
-			Delay:  int(rLink.Peer1Qos.Delay),
-			Jitter: int(rLink.Peer1Qos.Jitter),
+			Loss:   float64(rLink.GetPeer1Qos().GetLoss()),
+			Delay:  int(rLink.GetPeer1Qos().GetDelay()),
+			Jitter: int(rLink.GetPeer1Qos().GetJitter()),
 		},
 		Peer2QoS: QoSConfig{
-			Loss:   float64(rLink.Peer2Qos.Loss),
-			Delay:  int(rLink.Peer2Qos.Delay),
-			Jitter: int(rLink.Peer2Qos.Jitter),
+			Loss:   float64(rLink.GetPeer2Qos().GetLoss()),
+			Delay:  int(rLink.GetPeer2Qos().GetDelay()),
+			Jitter: int(rLink.GetPeer2Qos().GetJitter()),
 		},
 	}
 }
@@ -278,6 +282,16 @@ func (s *netemServer) ProjectGetNodeConfigs(ctx context.Context, request *proto.
 }
 
 func (s *netemServer) ProjectGetStatus(ctx context.Context, request *proto.ProjectRequest) (*proto.StatusResponse, error) {
+	return getProjectStatus(request, false)
+}
+
+// ProjectGetStats returns the status of the project with the resource
+// usage of the running docker nodes, one docker call is done per node
+func (s *netemServer) ProjectGetStats(ctx context.Context, request *proto.ProjectRequest) (*proto.StatusResponse, error) {
+	return getProjectStatus(request, true)
+}
+
+func getProjectStatus(request *proto.ProjectRequest, withStats bool) (*proto.StatusResponse, error) {
 	project := ProjectGetOne(request.GetId())
 	if project == nil {
 		return nil, &ProjectNotFoundError{request.GetId()}
@@ -289,12 +303,29 @@ func (s *netemServer) ProjectGetStatus(ctx context.Context, request *proto.Proje
 		Id:      project.Id,
 		OpenAt:  project.OpenAt.Format("2006-01-02 15:04:05"),
 		Running: project.Topology.IsRunning(),
+
+		ProjectLinks: project.Topology.GetProjectLinksStatus(),
+		PortForwards: project.Topology.GetPortForwardsStatus(),
 	}
 
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentNodeTask)
 	for _, node := range project.Topology.GetAllNodes() {
 		nodeStatus := &proto.StatusResponse_NodeStatus{
 			Name:    node.GetName(),
 			Running: node.IsRunning(),
+			Paused:  node.IsPaused(),
+		}
+		if nConfig := project.Topology.GetNodeConfig(node.GetName()); nConfig != nil {
+			nodeStatus.Description = nConfig.Description
+			nodeStatus.Icon = nConfig.Icon
+			nodeStatus.Labels = nConfig.Labels
+			if nConfig.Position != nil {
+				nodeStatus.Position = &proto.StatusResponse_Position{
+					X: nConfig.Position.X,
+					Y: nConfig.Position.Y,
+				}
+			}
 		}
 		if project.Topology.IsRunning() {
 			for ifName, state := range node.GetInterfacesState() {
@@ -309,6 +340,144 @@ func (s *netemServer) ProjectGetStatus(ctx context.Context, request *proto.Proje
 		}
 
 		response.Nodes = append(response.Nodes, nodeStatus)
+
+		if dNode, ok := node.(*docker.DockerNode); ok && withStats && node.IsRunning() {
+			g.Go(func() error {
+				stats, err := dNode.GetStats()
+				if err != nil {
+					logrus.Warnf("Unable to get stats of node %s: %v", dNode.GetName(), err)
+					return nil
+				}
+
+				nodeStatus.Stats = &proto.StatusResponse_NodeStats{
+					CpuPercent:  stats.CPUPercent,
+					MemoryUsage: stats.MemoryUsage,
+					MemoryLimit: stats.MemoryLimit,
+					Pids:        stats.Pids,
+					RxBytes:     stats.RxBytes,
+					TxBytes:     stats.TxBytes,
+				}
+				return nil
+			})
+		}
+	}
+	g.Wait()
+
+	return response, nil
+}
+
+func (s *netemServer) ProjectEvents(request *proto.ProjectRequest, stream proto.Netem_ProjectEventsServer) error {
+	project := ProjectGetOne(request.GetId())
+	if project == nil {
+		return &ProjectNotFoundError{request.GetId()}
+	}
+
+	eventsCh, unsubscribe := project.Topology.SubscribeEvents()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-eventsCh:
+			if !ok {
+				// the project has been closed
+				return nil
+			}
+			if err := stream.Send(&proto.NodeEventMsg{
+				Code: map[NodeEventCode]proto.NodeEventMsg_Code{
+					NODE_DIED:           proto.NodeEventMsg_DIED,
+					NODE_RESTARTED:      proto.NodeEventMsg_RESTARTED,
+					NODE_RESTART_FAILED: proto.NodeEventMsg_RESTART_FAILED,
+				}[event.Code],
+				Node:    event.Node,
+				Message: event.Message,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *netemServer) ProjectGetSnapshots(ctx context.Context, request *proto.ProjectRequest) (*proto.SnapshotsResponse, error) {
+	project := ProjectGetOne(request.GetId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetId()}
+	}
+
+	snapshots, err := project.Topology.GetSnapshots()
+	if err != nil {
+		return &proto.SnapshotsResponse{
+			Status: &proto.Status{
+				Code:  proto.StatusCode_ERROR,
+				Error: fmt.Sprintf("Unable to get snapshots: %v", err),
+			},
+		}, nil
+	}
+
+	response := &proto.SnapshotsResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}
+	for _, snapshot := range snapshots {
+		response.Snapshots = append(response.Snapshots, &proto.SnapshotsResponse_Snapshot{
+			Node: snapshot.Node,
+			Size: snapshot.Size,
+			Date: snapshot.ModTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *netemServer) ProjectPruneSnapshots(ctx context.Context, request *proto.NodeRequest) (*proto.AckResponse, error) {
+	project := ProjectGetOne(request.GetPrjId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjId()}
+	}
+
+	if err := project.Topology.PruneSnapshots(request.GetNode()); err != nil {
+		return &proto.AckResponse{
+			Status: &proto.Status{
+				Code:  proto.StatusCode_ERROR,
+				Error: fmt.Sprintf("Unable to prune snapshots: %v", err),
+			},
+		}, nil
+	}
+
+	return &proto.AckResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}, nil
+}
+
+func (s *netemServer) ProjectGetAddressing(ctx context.Context, request *proto.ProjectRequest) (*proto.AddressingResponse, error) {
+	project := ProjectGetOne(request.GetId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetId()}
+	}
+
+	response := &proto.AddressingResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}
+
+	plan := project.Topology.GetAddressingPlan()
+	if plan == nil {
+		return response, nil
+	}
+
+	response.Enabled = true
+	for _, a := range plan.Assignments {
+		assignment := &proto.AddressingResponse_Assignment{
+			Node:      a.Node,
+			Interface: a.IfName,
+			Network:   a.Network,
+		}
+		if a.IPv4.IsValid() {
+			assignment.Ipv4 = a.IPv4.String()
+		}
+		if a.IPv6.IsValid() {
+			assignment.Ipv6 = a.IPv6.String()
+		}
+		response.Assignments = append(response.Assignments, assignment)
 	}
 
 	return response, nil
@@ -398,6 +567,44 @@ func (s *netemServer) LinkDel(ctx context.Context, request *proto.LinkRequest) (
 	}, nil
 }
 
+func (s *netemServer) LinkRemoteConnect(ctx context.Context, request *proto.RemoteLinkRequest) (*proto.RemoteLinkResponse, error) {
+	project, err := ProjectGetByName(request.GetPrjName())
+	if err != nil {
+		return nil, err
+	} else if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjName()}
+	}
+
+	rl, err := project.Topology.AcceptRemoteLink(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RemoteLinkResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+		Vtep:   options.ServerConfig.Remote.Vtep,
+		Port:   int32(getRemotePort()),
+		Vni:    uint32(rl.Vni),
+	}, nil
+}
+
+func (s *netemServer) LinkRemoteDisconnect(ctx context.Context, request *proto.RemoteLinkRequest) (*proto.AckResponse, error) {
+	project, err := ProjectGetByName(request.GetPrjName())
+	if err != nil {
+		return nil, err
+	} else if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjName()}
+	}
+
+	if err := project.Topology.DisconnectRemoteLink(request); err != nil {
+		return nil, err
+	}
+
+	return &proto.AckResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}, nil
+}
+
 func toppologyRunProgressGoroutine(
 	ctx context.Context,
 	progressCh chan TopologyRunCloseProgressT,
@@ -499,24 +706,25 @@ func (s *netemServer) TopologyStartAll(ctx context.Context, request *proto.Proje
 		return nil, &ProjectNotFoundError{request.GetId()}
 	}
 
-	nodes := project.Topology.GetAllNodes()
 	g := new(errgroup.Group)
 	g.SetLimit(maxConcurrentNodeTask)
 
-	for _, node := range nodes {
-		node := node
-		if node.IsRunning() {
-			continue
-		}
+	// nodes are started wave by wave to respect dependencies
+	for _, wave := range project.Topology.GetNodeWaves() {
+		for _, node := range wave {
+			if node.Instance.IsRunning() {
+				continue
+			}
 
-		g.Go(func() error {
-			_, err := project.Topology.startNode(node)
-			return err
-		})
-	}
+			g.Go(func() error {
+				_, err := project.Topology.startNode(node.Instance)
+				return err
+			})
+		}
 
-	if err := g.Wait(); err != nil {
-		return nil, err
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
 	}
 
 	return &proto.AckResponse{
@@ -552,6 +760,33 @@ func (s *netemServer) TopologyStopAll(ctx context.Context, request *proto.Projec
 	}, nil
 }
 
+func (s *netemServer) TopologyExport(ctx context.Context, request *proto.TopologyExportRequest) (*proto.FileResponse, error) {
+	project := ProjectGetOne(request.GetId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetId()}
+	}
+
+	graph := project.Topology.GetGraph(project.Name, request.GetState())
+
+	var data []byte
+	var err error
+	switch request.GetFormat() {
+	case proto.TopologyExportRequest_DOT:
+		data = graph.ToDot()
+	case proto.TopologyExportRequest_SVG:
+		data = graph.ToSVG()
+	case proto.TopologyExportRequest_JSON:
+		if data, err = graph.ToJSON(); err != nil {
+			return nil, fmt.Errorf("unable to marshal topology graph: %w", err)
+		}
+	}
+
+	return &proto.FileResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+		Data:   data,
+	}, nil
+}
+
 func (s *netemServer) TopologyCheck(ctx context.Context, request *proto.ProjectRequest) (*proto.AckResponse, error) {
 	project := ProjectGetOne(request.GetId())
 	if project == nil {
@@ -620,6 +855,40 @@ func (s *netemServer) NodeRestart(ctx context.Context, request *proto.NodeReques
 	}, nil
 }
 
+func (s *netemServer) NodePause(ctx context.Context, request *proto.NodeRequest) (*proto.AckResponse, error) {
+	project := ProjectGetOne(request.GetPrjId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjId()}
+	}
+
+	if err := project.Topology.Pause(request.GetNode()); err != nil {
+		return &proto.AckResponse{
+			Status: &proto.Status{Code: proto.StatusCode_ERROR, Error: err.Error()},
+		}, nil
+	}
+
+	return &proto.AckResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}, nil
+}
+
+func (s *netemServer) NodeResume(ctx context.Context, request *proto.NodeRequest) (*proto.AckResponse, error) {
+	project := ProjectGetOne(request.GetPrjId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjId()}
+	}
+
+	if err := project.Topology.Resume(request.GetNode()); err != nil {
+		return &proto.AckResponse{
+			Status: &proto.Status{Code: proto.StatusCode_ERROR, Error: err.Error()},
+		}, nil
+	}
+
+	return &proto.AckResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}, nil
+}
+
 func (s *netemServer) NodeSetIfState(ctx context.Context, request *proto.NodeIfStateRequest) (*proto.AckResponse, error) {
 	project := ProjectGetOne(request.GetPrjId())
 	if project == nil {
@@ -648,6 +917,31 @@ func (s *netemServer) NodeSetIfState(ctx context.Context, request *proto.NodeIfS
 	}, nil
 }
 
+func (s *netemServer) NodePortForward(ctx context.Context, request *proto.PortForwardRequest) (*proto.AckResponse, error) {
+	project := ProjectGetOne(request.GetPrjId())
+	if project == nil {
+		return nil, &ProjectNotFoundError{request.GetPrjId()}
+	}
+
+	if project.Topology.GetNode(request.GetNode()) == nil {
+		return nil, &NodeNotFoundError{request.GetPrjId(), request.GetNode()}
+	}
+
+	var err error
+	if request.GetDelete() {
+		err = project.Topology.PortForwardDel(request.GetNode(), request.GetPort(), request.GetSync())
+	} else {
+		err = project.Topology.PortForwardAdd(request.GetNode(), request.GetPort(), request.GetSync())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AckResponse{
+		Status: &proto.Status{Code: proto.StatusCode_OK},
+	}, nil
+}
+
 func (s *netemServer) NodeCapture(request *proto.NodeInterfaceRequest, stream proto.Netem_NodeCaptureServer) error {
 	project := ProjectGetOne(request.GetPrjId())
 	if project == nil {
@@ -717,6 +1011,58 @@ func (s *netemServer) NodeCapture(request *proto.NodeInterfaceRequest, stream pr
 	return <-waitCh
 }
 
+type logsStreamWriter struct {
+	stream proto.Netem_NodeLogsServer
+	code   proto.LogsSrvMsg_Code
+}
+
+func (w *logsStreamWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := w.stream.Send(&proto.LogsSrvMsg{Code: w.code, Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *netemServer) NodeLogs(request *proto.NodeLogsRequest, stream proto.Netem_NodeLogsServer) error {
+	project := ProjectGetOne(request.GetPrjId())
+	if project == nil {
+		return stream.Send(&proto.LogsSrvMsg{
+			Code: proto.LogsSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Project %s not found", request.PrjId)),
+		})
+	}
+
+	node := project.Topology.GetNode(request.GetNode())
+	if node == nil {
+		return stream.Send(&proto.LogsSrvMsg{
+			Code: proto.LogsSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Node %s not found", request.GetNode())),
+		})
+	}
+
+	opts := docker.LogsOptions{
+		Follow: request.GetFollow(),
+		Tail:   request.GetTail(),
+		Since:  request.GetSince(),
+	}
+	stdout := &logsStreamWriter{stream: stream, code: proto.LogsSrvMsg_STDOUT}
+	stderr := &logsStreamWriter{stream: stream, code: proto.LogsSrvMsg_STDERR}
+
+	// the stream context is cancelled when the client leaves,
+	// which stops following logs
+	if err := node.Logs(stream.Context(), opts, stdout, stderr); err != nil {
+		return stream.Send(&proto.LogsSrvMsg{
+			Code: proto.LogsSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Unable to get logs of node %s: %v", node.GetName(), err)),
+		})
+	}
+
+	return nil
+}
+
 func (s *netemServer) NodeReadConfigFiles(ctx context.Context, request *proto.NodeRequest) (*proto.ConfigFilesResponse, error) {
 	project := ProjectGetOne(request.GetPrjId())
 	if project == nil {
@@ -867,6 +1213,132 @@ func (s *netemServer) NodeExec(stream proto.Netem_NodeExecServer) error {
 	return g.Wait()
 }
 
+func (s *netemServer) NodeDial(stream proto.Netem_NodeDialServer) error {
+	// read first msg from client
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if msg.GetCode() != proto.DialCltMsg_CONNECT {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte("Wrong code for first msg"),
+		})
+	}
+
+	// get project
+	project := ProjectGetOne(msg.GetPrjId())
+	if project == nil {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Project %s not found", msg.GetPrjId())),
+		})
+	}
+
+	// get node
+	node := project.Topology.GetNode(msg.GetNode())
+	if node == nil {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Node %s not found in project %s", msg.GetNode(), msg.GetPrjId())),
+		})
+	}
+	if !node.IsRunning() {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Node %s is not running", node.GetName())),
+		})
+	}
+
+	address := msg.GetAddress()
+	if address == "" {
+		address = "127.0.0.1"
+	}
+	address = net.JoinHostPort(address, strconv.Itoa(int(msg.GetPort())))
+
+	// the connection is opened from the netns of the node
+	ns, err := node.GetNetns()
+	if err != nil {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte(err.Error()),
+		})
+	}
+	conn, err := link.DialInNetns(ns, "tcp", address, nodeDialTimeout)
+	ns.Close()
+	if err != nil {
+		return stream.Send(&proto.DialSrvMsg{
+			Code: proto.DialSrvMsg_ERROR,
+			Data: []byte(fmt.Sprintf("Unable to connect to %s: %v", address, err)),
+		})
+	}
+	defer conn.Close()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"project": project.Id,
+		"node":    node.GetName(),
+	})
+	logger.Debugf("Start dial stream to %s", address)
+
+	if err := stream.Send(&proto.DialSrvMsg{Code: proto.DialSrvMsg_CONNECTED}); err != nil {
+		return err
+	}
+
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		for {
+			in, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				// unblock the read of the connection
+				conn.Close()
+				return err
+			}
+
+			if in.GetCode() == proto.DialCltMsg_CLOSE {
+				break
+			}
+			if _, err := conn.Write(in.GetData()); err != nil {
+				return err
+			}
+		}
+
+		// nothing more to send, the node can still answer
+		return conn.(*net.TCPConn).CloseWrite()
+	})
+
+	g.Go(func() error {
+		data := make([]byte, 32*1024)
+		for {
+			n, err := conn.Read(data)
+			if n > 0 {
+				if err := stream.Send(&proto.DialSrvMsg{
+					Code: proto.DialSrvMsg_DATA,
+					Data: data[:n],
+				}); err != nil {
+					return err
+				}
+			}
+
+			if err == io.EOF {
+				return stream.Send(&proto.DialSrvMsg{Code: proto.DialSrvMsg_CLOSE})
+			} else if err != nil {
+				return stream.Send(&proto.DialSrvMsg{
+					Code: proto.DialSrvMsg_ERROR,
+					Data: []byte(err.Error()),
+				})
+			}
+		}
+	})
+
+	err = g.Wait()
+	logger.Debug("Close dial stream")
+	return err
+}
+
 func (s *netemServer) NodeGetConsoleCmd(ctx context.Context, request *proto.ConsoleCmdRequest) (*proto.ConsoleCmdResponse, error) {
 	// get project
 	project := ProjectGetOne(request.GetPrjId())
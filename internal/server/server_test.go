@@ -3,17 +3,26 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"io"
 	stdlog "log"
+	"math/big"
 	"net"
 	"os"
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/mroy31/gonetem/internal/options"
 	"github.com/mroy31/gonetem/internal/proto"
 	"github.com/mroy31/gonetem/internal/utils"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -257,3 +266,142 @@ nodes:
 		t.Errorf("Saved network has not the expected content")
 	}
 }
+
+// writeTestCert writes in dir a certificate signed by parent, or
+// self-signed if parent is nil, and its key
+func writeTestCert(t *testing.T, dir, name string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (string, string, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath := path.Join(dir, name+"-cert.pem")
+	keyPath := path.Join(dir, name+"-key.pem")
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certPath, keyPath, cert, key
+}
+
+func TestServer_RemoteTLS(t *testing.T) {
+	options.InitServerConfig()
+	defer options.InitServerConfig()
+
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+	caPath, _, ca, caKey := writeTestCert(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gonetem-ca"},
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	// certificate restricted to server authentication, the two servers
+	// running on the loopback use it
+	serverCert, serverKey, _, _ := writeTestCert(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "gonetem-server"},
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	clientCert, clientKey, _, _ := writeTestCert(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "gonetem-client"},
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	options.ServerConfig.Tls = options.TLSOptions{
+		Enabled: true,
+		Ca:      caPath,
+		Cert:    serverCert,
+		Key:     serverKey,
+	}
+
+	// remote server listening on the loopback
+	creds, err := options.LoadServerTLSCredentials()
+	if err != nil {
+		t.Fatalf("Unable to load server credentials: %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	remoteServer := grpc.NewServer(grpc.Creds(creds))
+	proto.RegisterNetemServer(remoteServer, &netemServer{})
+	go remoteServer.Serve(listener)
+	defer remoteServer.Stop()
+
+	options.ServerConfig.Remote.Servers = map[string]string{"serverB": listener.Addr().String()}
+
+	// the server certificate can not be used as client certificate
+	if _, _, err := newRemoteClient("serverB"); err == nil {
+		t.Fatalf("Server certificate without clientAuth usage must be refused")
+	}
+
+	options.ServerConfig.Remote.Tls = options.RemoteTLSOptions{Cert: clientCert, Key: clientKey}
+	conn, client, err := newRemoteClient("serverB")
+	if err != nil {
+		t.Fatalf("Unable to create remote client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := client.ServerGetVersion(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("Unable to call the remote server: %v", err)
+	}
+	if response.GetVersion() != options.VERSION {
+		t.Errorf("Wrong version of the remote server %s", response.GetVersion())
+	}
+}
+
+func TestServer_RemoteUnreachable(t *testing.T) {
+	options.InitServerConfig()
+	defer options.InitServerConfig()
+
+	// nothing listens on this port once the listener is closed
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener.Close()
+	options.ServerConfig.Remote.Servers = map[string]string{"serverB": listener.Addr().String()}
+
+	topo := &NetemTopologyManager{logger: logrus.WithField("project", "test")}
+	topo.remoteLinks = []*NetemRemoteLink{{
+		Peer:   NetemLinkPeer{Node: &graphTestNode{name: "R1"}, IfIndex: 0},
+		Config: LinkConfig{Peer1: "R1.0", Peer2: "remote://serverB/prj/R2.0"},
+		Remote: RemotePeer{Server: "serverB", Project: "prj", Peer: "R2.0"},
+	}}
+
+	messages := topo.connectRemoteLinks()
+	if len(messages["R1"]) != 1 {
+		t.Fatalf("Unexpected messages for an unreachable server: %v", messages)
+	}
+	if topo.remoteLinks[0].Vni != 0 {
+		t.Errorf("VNI of the link not connected is not released")
+	}
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/mroy31/gonetem/internal/docker"
+	"github.com/mroy31/gonetem/internal/options"
+)
+
+type SnapshotInfo struct {
+	Node    string
+	Size    int64
+	ModTime time.Time
+}
+
+func (t *NetemTopologyManager) getSnapshotPath() string {
+	return path.Join(t.path, snapshotDir)
+}
+
+// restoreSnapshot applies the stored filesystem snapshot of a node
+// with the snapshot persist mode
+func (t *NetemTopologyManager) restoreSnapshot(node INetemNode) error {
+	nConfig := t.GetNodeConfig(node.GetName())
+	if nConfig == nil || nConfig.Persist != "snapshot" {
+		return nil
+	}
+
+	dNode, ok := node.(*docker.DockerNode)
+	if !ok {
+		return nil
+	}
+
+	timeout := options.ServerConfig.Docker.Timeoutop
+	if err := dNode.RestoreSnapshot(t.getSnapshotPath(), timeout); err != nil {
+		return fmt.Errorf("node %s: %w", node.GetName(), err)
+	}
+	return nil
+}
+
+func (t *NetemTopologyManager) saveSnapshot(node NetemNode) error {
+	if node.Config.Persist != "snapshot" {
+		return nil
+	}
+
+	dNode, ok := node.Instance.(*docker.DockerNode)
+	if !ok {
+		return nil
+	}
+
+	timeout := options.ServerConfig.Docker.Timeoutop
+	if err := dNode.SaveSnapshot(t.getSnapshotPath(), timeout); err != nil {
+		return fmt.Errorf("node %s: unable to save snapshot - %w", node.Instance.GetName(), err)
+	}
+	return nil
+}
+
+// GetSnapshots returns the snapshots stored in the project
+func (t *NetemTopologyManager) GetSnapshots() ([]SnapshotInfo, error) {
+	var snapshots []SnapshotInfo
+
+	for _, node := range t.nodes {
+		name := node.Instance.GetName()
+		stat, err := os.Stat(path.Join(t.getSnapshotPath(), docker.SnapshotArchiveName(name)))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return snapshots, err
+		}
+
+		snapshots = append(snapshots, SnapshotInfo{
+			Node:    name,
+			Size:    stat.Size(),
+			ModTime: stat.ModTime(),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Node < snapshots[j].Node
+	})
+	return snapshots, nil
+}
+
+// PruneSnapshots deletes the stored snapshot of a node, or of all
+// the nodes if nodeName is "all". Changes of the running containers are
+// not saved in a new snapshot until the project is reopened
+func (t *NetemTopologyManager) PruneSnapshots(nodeName string) error {
+	var nodes []INetemNode
+	if nodeName == "all" {
+		if err := os.RemoveAll(t.getSnapshotPath()); err != nil {
+			return err
+		}
+		nodes = t.GetAllNodes()
+	} else {
+		node := t.GetNode(nodeName)
+		if node == nil {
+			return fmt.Errorf("node %s not found in the topology", nodeName)
+		}
+
+		for _, filename := range []string{docker.SnapshotArchiveName(nodeName), docker.SnapshotDeletedName(nodeName)} {
+			if err := os.Remove(path.Join(t.getSnapshotPath(), filename)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	for _, node := range nodes {
+		if dNode, ok := node.(*docker.DockerNode); ok {
+			dNode.PruneSnapshot()
+		}
+	}
+	return nil
+}
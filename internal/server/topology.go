@@ -1,20 +1,26 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/creasty/defaults"
+	"github.com/mroy31/gonetem/internal/docker"
 	"github.com/mroy31/gonetem/internal/link"
 	"github.com/mroy31/gonetem/internal/options"
 	"github.com/mroy31/gonetem/internal/ovs"
 	"github.com/mroy31/gonetem/internal/proto"
+	"github.com/mroy31/gonetem/internal/topology"
 	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
 	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
@@ -23,6 +29,7 @@ import (
 const (
 	networkFilename       = "network.yml"
 	configDir             = "configs"
+	snapshotDir           = "snapshots"
 	maxConcurrentNodeTask = 30
 )
 
@@ -30,104 +37,28 @@ var (
 	mutex = &sync.Mutex{}
 )
 
-type QoSConfig struct {
-	Loss   float64 `yaml:",omitempty"` // percent
-	Delay  int     `yaml:",omitempty"` // ms
-	Jitter int     `yaml:",omitempty"` // ms
-	Rate   int     `yaml:",omitempty"` // kbps
-	Buffer float64 `yaml:",omitempty"` // BDP scale factor
-}
-
-type LinkConfig struct {
-	Peer1    string
-	Peer2    string
-	Loss     float64 `yaml:",omitempty"` // percent
-	Delay    int     `yaml:",omitempty"` // ms
-	Jitter   int     `yaml:",omitempty"` // ms
-	Rate     int     `yaml:",omitempty"` // kbps
-	Buffer   float64 `yaml:",omitempty"` // BDP scale factor
-	Peer1QoS QoSConfig
-	Peer2QoS QoSConfig
-}
-
-func (l *LinkConfig) GetPeer1QoS() QoSConfig {
-	if l.Peer1QoS.Loss > 0 ||
-		l.Peer1QoS.Delay > 0 ||
-		l.Peer1QoS.Jitter > 0 ||
-		l.Peer1QoS.Rate > 0 ||
-		l.Peer1QoS.Buffer > 0 {
-		return l.Peer1QoS
-	}
-
-	return QoSConfig{
-		Loss:   l.Loss,
-		Delay:  l.Delay,
-		Jitter: l.Jitter,
-		Rate:   l.Rate,
-		Buffer: l.Buffer,
-	}
-}
-
-func (l *LinkConfig) GetPeer2QoS() QoSConfig {
-	if l.Peer2QoS.Loss > 0 ||
-		l.Peer2QoS.Delay > 0 ||
-		l.Peer2QoS.Jitter > 0 ||
-		l.Peer2QoS.Rate > 0 ||
-		l.Peer2QoS.Buffer > 0 {
-		return l.Peer2QoS
-	}
-
-	return QoSConfig{
-		Loss:   l.Loss,
-		Delay:  l.Delay,
-		Jitter: l.Jitter,
-		Rate:   l.Rate,
-		Buffer: l.Buffer,
-	}
-}
-
-type BridgeConfig struct {
-	Host       string
-	Interfaces []string `yaml:",omitempty"`
-}
-
-type MgntNetworkConfig struct {
-	Enable  bool   `yaml:",omitempty" default:"false"`
-	Address string `yaml:",omitempty"`
-}
-type VrrpOptions struct {
-	Interface int
-	Group     int
-	Address   string
-}
-
-type MgntOptions struct {
-	Enable  bool   `yaml:",omitempty" default:"false"`
-	Address string `yaml:",omitempty"`
-}
-
-type NodeConfig struct {
-	Type    string
-	IPv6    bool          `yaml:",omitempty" default:"true"`
-	Mpls    bool          `yaml:",omitempty" default:"false"`
-	Vrfs    []string      `yaml:",omitempty"`
-	Vrrps   []VrrpOptions `yaml:",omitempty"`
-	Volumes []string      `yaml:",omitempty"`
-	Image   string        `yaml:",omitempty"`
-	Launch  bool          `default:"true"`
-	Mgnt    MgntOptions   `yaml:",omitempty"`
-}
-
-func (n *NodeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	defaults.Set(n)
-
-	type plain NodeConfig
-	if err := unmarshal((*plain)(n)); err != nil {
-		return err
-	}
+// The types of the topology file are declared in the topology package,
+// to be shared with the console
+type (
+	NetemTopology     = topology.NetemTopology
+	QoSConfig         = topology.QoSConfig
+	LinkConfig        = topology.LinkConfig
+	BridgeConfig      = topology.BridgeConfig
+	LagConfig         = topology.LagConfig
+	SegmentConfig     = topology.SegmentConfig
+	SegmentMember     = topology.SegmentMember
+	NodeConfig        = topology.NodeConfig
+	InterfaceConfig   = topology.InterfaceConfig
+	VlanConfig        = topology.VlanConfig
+	RouteConfig       = topology.RouteConfig
+	AddressingConfig  = topology.AddressingConfig
+	MgntNetworkConfig = topology.MgntNetworkConfig
+	MgntOptions       = topology.MgntOptions
+	VrrpOptions       = topology.VrrpOptions
+	NodePosition      = topology.NodePosition
+)
 
-	return nil
-}
+const NoProfile = topology.NoProfile
 
 type RunCloseProgressCode int
 type SaveProgressCode int
@@ -161,13 +92,6 @@ type TopologySaveProgressT struct {
 	Value int
 }
 
-type NetemTopology struct {
-	Nodes   map[string]NodeConfig   `yaml:",omitempty"`
-	Links   []LinkConfig            `yaml:",omitempty"`
-	Bridges map[string]BridgeConfig `yaml:",omitempty"`
-	Mgntnet MgntNetworkConfig       `yaml:",omitempty"`
-}
-
 type NetemLinkPeer struct {
 	Node    INetemNode
 	IfIndex int
@@ -192,7 +116,7 @@ func (l *NetemLink) SetPeer1TBF(ifName string, ns netns.NsHandle) error {
 
 	// create tbf qdisc if necessary
 	if peerQoS.Rate > 0 {
-		if err := link.CreateTbf(ifName, ns, peerQoS.Delay+l.Config.Jitter, peerQoS.Rate, peerQoS.Buffer, l.HasPeer1Tbf); err != nil {
+		if err := link.CreateTbf(ifName, ns, peerQoS.Delay+peerQoS.Jitter, peerQoS.Rate, peerQoS.Buffer, l.HasPeer1Tbf); err != nil {
 			return err
 		}
 		l.HasPeer1Tbf = true
@@ -206,7 +130,7 @@ func (l *NetemLink) SetPeer2TBF(ifName string, ns netns.NsHandle) error {
 
 	// create tbf qdisc if necessary
 	if peerQoS.Rate > 0 {
-		if err := link.CreateTbf(ifName, ns, peerQoS.Delay+l.Config.Jitter, peerQoS.Rate, peerQoS.Buffer, l.HasPeer2Tbf); err != nil {
+		if err := link.CreateTbf(ifName, ns, peerQoS.Delay+peerQoS.Jitter, peerQoS.Rate, peerQoS.Buffer, l.HasPeer2Tbf); err != nil {
 			return err
 		}
 		l.HasPeer2Tbf = true
@@ -242,11 +166,25 @@ func (l *NetemLink) SetPeer2Netem(ifName string, ns netns.NsHandle) error {
 	return nil
 }
 
+func (l *NetemLink) SetAlias(peer1IfName string, peer1Ns netns.NsHandle, peer2IfName string, peer2Ns netns.NsHandle) error {
+	if l.Config.Description == "" {
+		return nil
+	}
+
+	if err := link.SetInterfaceAlias(peer1IfName, peer1Ns, l.Config.Description); err != nil {
+		return err
+	}
+	return link.SetInterfaceAlias(peer2IfName, peer2Ns, l.Config.Description)
+}
+
 type NetemBridge struct {
 	Name          string
 	HostInterface string
 	Peers         []NetemLinkPeer
 	Config        BridgeConfig
+
+	// state of the host interface moved in a node, with direct passthrough
+	hostIf *link.HostInterface
 }
 
 type NetemNode struct {
@@ -263,10 +201,35 @@ type NetemTopologyManager struct {
 	nodes       []NetemNode
 	ovsInstance *ovs.OvsProjectInstance
 	links       []*NetemLink
+	lags        []*NetemLag
 	bridges     []*NetemBridge
-	mgntNet     *MgntNetwork
-	running     bool
-	logger      *logrus.Entry
+	segments    []*NetemSegment
+	remoteLinks []*NetemRemoteLink
+	// links accepted from other servers are added by the API
+	remoteLinksLock sync.Mutex
+	// declared links to other projects and links of other projects
+	// connected to this one
+	projectLinks []*NetemProjectLink
+	portForwards []*NetemPortForward
+	forwardsLock sync.Mutex
+	profiles     map[string]QoSConfig
+	addressing   AddressingConfig
+	plan         *AddressingPlan
+	mgntNet      *MgntNetwork
+	running      atomic.Bool
+	logger       *logrus.Entry
+
+	// lock serializes the changes of nodes and links done by the API
+	// and by the events watcher
+	lock sync.Mutex
+
+	eventsCancel context.CancelFunc
+	// events watcher and restarts of crashed nodes in progress
+	eventsWg     sync.WaitGroup
+	eventsLock   sync.Mutex
+	subscribers  map[chan NodeEvent]struct{}
+	restarts     map[string]int
+	lastRestarts map[string]time.Time
 }
 
 func (t *NetemTopologyManager) Check() error {
@@ -285,9 +248,11 @@ func (t *NetemTopologyManager) Check() error {
 
 func (t *NetemTopologyManager) SynchroniseTopology() error {
 	topo := &NetemTopology{
-		Nodes:   make(map[string]NodeConfig),
-		Links:   make([]LinkConfig, 0),
-		Bridges: make(map[string]BridgeConfig),
+		Nodes:      make(map[string]NodeConfig),
+		Links:      make([]LinkConfig, 0),
+		Bridges:    make(map[string]BridgeConfig),
+		Profiles:   t.profiles,
+		Addressing: t.addressing,
 	}
 
 	for _, node := range t.nodes {
@@ -295,13 +260,43 @@ func (t *NetemTopologyManager) SynchroniseTopology() error {
 	}
 
 	for _, link := range t.links {
-		topo.Links = append(topo.Links, link.Config)
+		if !t.isLagMember(link) {
+			topo.Links = append(topo.Links, link.Config)
+		}
+	}
+
+	for _, rl := range t.getRemoteLinks() {
+		if !rl.Accepted {
+			topo.Links = append(topo.Links, rl.Config)
+		}
+	}
+
+	for _, pl := range t.projectLinks {
+		if pl.Owner == t {
+			topo.Links = append(topo.Links, pl.Link.Config)
+		}
+	}
+
+	for _, lag := range t.lags {
+		lagConfig := lag.Config
+		lagConfig.Links = make([]LinkConfig, len(lag.Links))
+		for idx, link := range lag.Links {
+			lagConfig.Links[idx] = link.Config
+		}
+		topo.Lags = append(topo.Lags, lagConfig)
 	}
 
 	for _, bridge := range t.bridges {
 		topo.Bridges[bridge.Name] = bridge.Config
 	}
 
+	if len(t.segments) > 0 {
+		topo.Segments = make(map[string]SegmentConfig)
+		for _, seg := range t.segments {
+			topo.Segments[seg.Name] = seg.Config
+		}
+	}
+
 	if t.mgntNet != nil {
 		topo.Mgntnet = MgntNetworkConfig{
 			Enable:  true,
@@ -382,33 +377,49 @@ func (t *NetemTopologyManager) Load() error {
 	}
 
 	// Create links
-	t.links = make([]*NetemLink, len(topology.Links))
-	for idx, lConfig := range topology.Links {
-		peer1 := strings.Split(lConfig.Peer1, ".")
-		peer2 := strings.Split(lConfig.Peer2, ".")
-
-		peer1Idx, _ := strconv.Atoi(peer1[1])
-		peer2Idx, _ := strconv.Atoi(peer2[1])
-
-		if lConfig.Buffer == 0.0 {
-			// by default set limit buffer to 1.0 * BDP
-			lConfig.Buffer = 1.0
+	t.profiles = topology.Profiles
+	t.links = make([]*NetemLink, 0, len(topology.Links))
+	remoteLinks := make([]*NetemRemoteLink, 0)
+	t.projectLinks = make([]*NetemProjectLink, 0)
+	for _, lConfig := range topology.Links {
+		if IsRemotePeer(lConfig.Peer2) {
+			rl, err := t.newRemoteLink(lConfig)
+			if err != nil {
+				return err
+			}
+			remoteLinks = append(remoteLinks, rl)
+			continue
+		}
+		if IsProjectPeer(lConfig.Peer2) {
+			pl, err := t.newProjectLink(lConfig)
+			if err != nil {
+				return err
+			}
+			t.projectLinks = append(t.projectLinks, pl)
+			continue
 		}
 
-		t.links[idx] = &NetemLink{
-			Peer1: NetemLinkPeer{
-				Node:    t.GetNode(peer1[0]),
-				IfIndex: peer1Idx,
-			},
-			Peer2: NetemLinkPeer{
-				Node:    t.GetNode(peer2[0]),
-				IfIndex: peer2Idx,
-			},
-			HasPeer1Netem: false,
-			HasPeer2Netem: false,
-			HasPeer1Tbf:   false,
-			HasPeer2Tbf:   false,
-			Config:        lConfig,
+		l, err := t.newLink(lConfig)
+		if err != nil {
+			return err
+		}
+		t.links = append(t.links, l)
+	}
+	t.remoteLinksLock.Lock()
+	t.remoteLinks = remoteLinks
+	t.remoteLinksLock.Unlock()
+
+	// Create lags, member links are managed like other links
+	t.lags = make([]*NetemLag, len(topology.Lags))
+	for idx, lagConfig := range topology.Lags {
+		t.lags[idx] = &NetemLag{Config: lagConfig}
+		for _, lConfig := range lagConfig.Links {
+			l, err := t.newLink(lConfig)
+			if err != nil {
+				return err
+			}
+			t.lags[idx].Links = append(t.lags[idx].Links, l)
+			t.links = append(t.links, l)
 		}
 	}
 
@@ -441,6 +452,45 @@ func (t *NetemTopologyManager) Load() error {
 		bIdx++
 	}
 
+	// Create segments
+	t.segments = make([]*NetemSegment, 0, len(topology.Segments))
+	for sName, sConfig := range topology.Segments {
+		shortName, err := t.IdGenerator.GetId(sName)
+		if err != nil {
+			return err
+		}
+
+		seg := &NetemSegment{
+			Name:   sName,
+			BrName: "seg." + shortName,
+			Peers:  make([]NetemLinkPeer, len(sConfig.Members)),
+			Config: sConfig,
+		}
+		for pIdx, member := range sConfig.Members {
+			peer := strings.Split(member.Peer, ".")
+			peerIdx, _ := strconv.Atoi(peer[1])
+
+			seg.Peers[pIdx] = NetemLinkPeer{
+				Node:    t.GetNode(peer[0]),
+				IfIndex: peerIdx,
+			}
+		}
+		t.segments = append(t.segments, seg)
+	}
+
+	// generate addressing plan and node configs if necessary
+	t.addressing = topology.Addressing
+	t.plan = nil
+	if t.addressing.IsEnabled() {
+		t.plan, err = ComputeAddressingPlan(topology)
+		if err != nil {
+			return err
+		}
+		if err := t.plan.GenerateConfigs(path.Join(t.path, configDir)); err != nil {
+			return err
+		}
+	}
+
 	// create mgnt network if necessary
 	t.mgntNet = nil
 	if topology.Mgntnet.Enable {
@@ -455,6 +505,34 @@ func (t *NetemTopologyManager) Load() error {
 	return nil
 }
 
+func (t *NetemTopologyManager) newLink(lConfig LinkConfig) (*NetemLink, error) {
+	peer1 := strings.Split(lConfig.Peer1, ".")
+	peer2 := strings.Split(lConfig.Peer2, ".")
+
+	peer1Idx, _ := strconv.Atoi(peer1[1])
+	peer2Idx, _ := strconv.Atoi(peer2[1])
+
+	if err := lConfig.SetProfile(lConfig.Profile, t.profiles); err != nil {
+		return nil, err
+	}
+
+	return &NetemLink{
+		Peer1: NetemLinkPeer{
+			Node:    t.GetNode(peer1[0]),
+			IfIndex: peer1Idx,
+		},
+		Peer2: NetemLinkPeer{
+			Node:    t.GetNode(peer2[0]),
+			IfIndex: peer2Idx,
+		},
+		HasPeer1Netem: false,
+		HasPeer2Netem: false,
+		HasPeer1Tbf:   false,
+		HasPeer2Tbf:   false,
+		Config:        lConfig,
+	}, nil
+}
+
 func (t *NetemTopologyManager) Reload(progressCh chan TopologyRunCloseProgressT) ([]*proto.TopologyRunMsg_NodeMessages, error) {
 	t.logger.Debug("Topo/Reload")
 
@@ -469,8 +547,8 @@ func (t *NetemTopologyManager) Reload(progressCh chan TopologyRunCloseProgressT)
 		return nodeMessages, err
 	}
 
-	if t.running {
-		t.running = false
+	if t.running.Load() {
+		t.running.Store(false)
 		return t.Run(progressCh)
 	}
 
@@ -479,6 +557,9 @@ func (t *NetemTopologyManager) Reload(progressCh chan TopologyRunCloseProgressT)
 
 func (t *NetemTopologyManager) Run(progressCh chan TopologyRunCloseProgressT) ([]*proto.TopologyRunMsg_NodeMessages, error) {
 	t.logger.Debug("Topo/Run")
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
 	if progressCh != nil {
 
 		progressCh <- TopologyRunCloseProgressT{Code: NODE_COUNT, Value: len(t.nodes)}
@@ -489,7 +570,7 @@ func (t *NetemTopologyManager) Run(progressCh chan TopologyRunCloseProgressT) ([
 	var err error
 	var nodeMessages []*proto.TopologyRunMsg_NodeMessages
 
-	if t.running {
+	if t.running.Load() {
 		t.logger.Warn("Topology is already running")
 		return nodeMessages, nil
 	}
@@ -508,32 +589,63 @@ func (t *NetemTopologyManager) Run(progressCh chan TopologyRunCloseProgressT) ([
 		}
 	}
 
-	// 3 - start all required nodes
+	// 3 - start all required nodes and wait they are ready
 	g := new(errgroup.Group)
 	g.SetLimit(maxConcurrentNodeTask)
 
-	t.logger.Debug("Topo/Run: start nodes")
-	for _, node := range t.nodes {
-		node := node
-		g.Go(func() error {
-			var err error = nil
-
-			if node.LaunchAtStartup {
-				err = node.Instance.Start()
-			}
+	var msgLock sync.Mutex
+	addNodeMessages := func(name string, messages []string) {
+		msgLock.Lock()
+		defer msgLock.Unlock()
 
-			if err == nil && node.Config.Mgnt.Enable {
-				err = t.setupMgntLink(&node)
+		// a node has only one entry, readiness and config messages are merged
+		for _, nMessages := range nodeMessages {
+			if nMessages.Name == name {
+				nMessages.Messages = append(nMessages.Messages, messages...)
+				return
 			}
-
-			if progressCh != nil {
-				progressCh <- TopologyRunCloseProgressT{Code: START_NODE}
-			}
-			return err
+		}
+		nodeMessages = append(nodeMessages, &proto.TopologyRunMsg_NodeMessages{
+			Name:     name,
+			Messages: messages,
 		})
 	}
-	if err := g.Wait(); err != nil {
-		return nodeMessages, err
+
+	timeout := options.ServerConfig.Docker.Timeoutop
+	waves := t.GetNodeWaves()
+	t.logger.Debug("Topo/Run: start nodes")
+	for _, wave := range waves {
+		for _, node := range wave {
+			g.Go(func() error {
+				var err error = nil
+
+				if node.LaunchAtStartup {
+					err = node.Instance.Start()
+					if err == nil {
+						// a node which is not ready is reported but does not
+						// prevent the topology to run
+						if rErr := node.Instance.WaitReady(timeout); rErr != nil {
+							t.logger.Warnf("Readiness probe failed: %v", rErr)
+							addNodeMessages(node.Instance.GetName(), []string{
+								fmt.Sprintf("Readiness probe failed: %v", rErr)})
+						}
+					}
+				}
+
+				if err == nil && node.Config.Mgnt.Enable {
+					err = t.setupMgntLink(&node)
+				}
+
+				if progressCh != nil {
+					progressCh <- TopologyRunCloseProgressT{Code: START_NODE}
+				}
+				return err
+			})
+		}
+		// wait the end of the wave before starting the next one
+		if err := g.Wait(); err != nil {
+			return nodeMessages, err
+		}
 	}
 
 	// 3 - create links
@@ -565,42 +677,84 @@ func (t *NetemTopologyManager) Run(progressCh chan TopologyRunCloseProgressT) ([
 		return nodeMessages, err
 	}
 
+	// create segments
+	t.logger.Debug("Topo/Run: setup segments")
+	for _, seg := range t.segments {
+		if err := t.setupSegment(seg); err != nil {
+			return nodeMessages, err
+		}
+	}
+
+	// connect remote links, the remote projects have to be running
+	t.logger.Debug("Topo/Run: connect remote links")
+	for name, messages := range t.connectRemoteLinks() {
+		addNodeMessages(name, messages)
+	}
+
+	// connect links with other projects open on the server
+	t.logger.Debug("Topo/Run: connect project links")
+	if err := t.connectProjectLinks(); err != nil {
+		return nodeMessages, err
+	}
+
 	// 5 - load configs
 	t.logger.Debug("Topo/Run: load configuration")
-	timeout := options.ServerConfig.Docker.Timeoutop
 	configPath := path.Join(t.path, configDir)
-	for _, node := range t.nodes {
-		g.Go(func() error {
-			var messages []string
-			var err error = nil
-
-			if node.Instance.IsRunning() {
-				if err = node.Instance.ConfigureInterfaces(); err != nil {
-					return err
+	for _, wave := range waves {
+		for _, node := range wave {
+			g.Go(func() error {
+				var messages []string
+				var err error = nil
+
+				if node.Instance.IsRunning() {
+					if err = node.Instance.ConfigureInterfaces(); err != nil {
+						return err
+					}
+					if err = t.configureNodeLags(node.Instance); err != nil {
+						return err
+					}
+					if err = t.configureNodeInterfaces(node.Instance); err != nil {
+						return err
+					}
+					if err = t.restoreSnapshot(node.Instance); err != nil {
+						return err
+					}
+
+					messages, err = node.Instance.LoadConfig(configPath, timeout)
+					addNodeMessages(node.Instance.GetName(), messages)
+					if err == nil {
+						// routes may use vlan interfaces created with the config
+						err = t.configureNodeRoutes(node.Instance)
+					}
+				}
+				if progressCh != nil {
+					progressCh <- TopologyRunCloseProgressT{Code: LOADCONFIG_NODE}
 				}
 
-				messages, err = node.Instance.LoadConfig(configPath, timeout)
-				nodeMessages = append(nodeMessages, &proto.TopologyRunMsg_NodeMessages{
-					Name:     node.Instance.GetName(),
-					Messages: messages,
-				})
-			}
-			if progressCh != nil {
-				progressCh <- TopologyRunCloseProgressT{Code: LOADCONFIG_NODE}
-			}
-
-			return err
-		})
+				return err
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nodeMessages, err
+		}
 	}
-	if err := g.Wait(); err != nil {
-		return nodeMessages, err
+
+	// forward host ports once the services of nodes are configured
+	t.logger.Debug("Topo/Run: start port forwards")
+	for name, messages := range t.startPortForwards() {
+		addNodeMessages(name, messages)
 	}
 
-	t.running = true
+	t.running.Store(true)
+	t.startEventsWatcher()
 	return nodeMessages, nil
 }
 
 func (t *NetemTopologyManager) setupBridge(br *NetemBridge) error {
+	if br.Config.Passthrough != "" {
+		return t.setupPassthrough(br)
+	}
+
 	rootNs := link.GetRootNetns()
 	defer rootNs.Close()
 
@@ -613,37 +767,66 @@ func (t *NetemTopologyManager) setupBridge(br *NetemBridge) error {
 		return fmt.Errorf("unable to attach HostIf to bridge %s: %v", br.Name, err)
 	}
 
+	if br.Config.Description != "" {
+		if err := link.SetInterfaceAlias(br.Name, rootNs, br.Config.Description); err != nil {
+			return err
+		}
+	}
+
 	for _, peer := range br.Peers {
-		peerNetns, err := peer.Node.GetNetns()
-		if err != nil {
+		if err := t.setupBridgePeer(br, brId, peer, rootNs, false); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+func (t *NetemTopologyManager) setupBridgePeer(br *NetemBridge, brId *netlink.Bridge, peer NetemLinkPeer, rootNs netns.NsHandle, configure bool) error {
+	peerNetns, err := peer.Node.GetNetns()
+	if err != nil {
+		return err
+	}
+	defer peerNetns.Close()
 
-		ifName := fmt.Sprintf("%s%s%s.%d", options.NETEM_ID, t.prjID, peer.Node.GetShortName(), peer.IfIndex)
-		peerIfName := peer.Node.GetInterfaceName(peer.IfIndex)
-		veth, err := link.CreateVethLink(
-			ifName, rootNs,
-			peerIfName, peerNetns,
+	ifName := fmt.Sprintf("%s%s%s.%d", options.NETEM_ID, t.prjID, peer.Node.GetShortName(), peer.IfIndex)
+	peerIfName := peer.Node.GetInterfaceName(peer.IfIndex)
+	veth, err := link.CreateVethLink(
+		ifName, rootNs,
+		peerIfName, peerNetns,
+		link.VethOptions{
+			MTU:     br.Config.Mtu,
+			MAC:     link.GenerateMAC(fmt.Sprintf("%s/%s/%s.%d", t.projectName(), br.Name, peer.Node.GetName(), peer.IfIndex)),
+			PeerMAC: t.getInterfaceMAC(peer.Node, peer.IfIndex),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to create link %s-%s.%d: %v",
+			br.Name, peer.Node.GetName(), peer.IfIndex, err,
 		)
-		if err != nil {
-			return fmt.Errorf(
-				"unable to create link %s-%s.%d: %v",
-				br.Name, peer.Node.GetName(), peer.IfIndex, err,
-			)
-		}
+	}
 
-		// set interface up
-		if err := link.SetInterfaceState(veth.Name, rootNs, link.IFSTATE_UP); err != nil {
+	if br.Config.Description != "" {
+		if err := link.SetInterfaceAlias(peerIfName, peerNetns, br.Config.Description); err != nil {
 			return err
 		}
+	}
 
-		if err := link.AttachToBridge(brId, veth.Name, rootNs); err != nil {
-			return err
-		}
+	// set interface up
+	if err := link.SetInterfaceState(veth.Name, rootNs, link.IFSTATE_UP); err != nil {
+		return err
+	}
 
-		if err := peer.Node.AttachInterface(peerIfName, peer.IfIndex, false); err != nil {
-			return err
-		}
+	if err := link.AttachToBridge(brId, veth.Name, rootNs); err != nil {
+		return err
+	}
+
+	if err := peer.Node.AttachInterface(peerIfName, peer.IfIndex, configure); err != nil {
+		return err
+	}
+	if configure {
+		return t.configureNodeNetwork(peer.Node)
 	}
 
 	return nil
@@ -667,6 +850,10 @@ func (t *NetemTopologyManager) setupMgntLink(node *NetemNode) error {
 	veth, err := link.CreateVethLink(
 		mgntIfname, rootNs,
 		peerIfname, peerNetns,
+		link.VethOptions{
+			MAC:     link.GenerateMAC(fmt.Sprintf("%s/mgnt/%s", t.projectName(), node.Instance.GetName())),
+			PeerMAC: link.GenerateMAC(fmt.Sprintf("%s/%s/%s", t.projectName(), node.Instance.GetName(), peerIfname)),
+		},
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -691,6 +878,23 @@ func (t *NetemTopologyManager) setupMgntLink(node *NetemNode) error {
 	return nil
 }
 
+// getInterfaceMAC returns the MAC address declared for the interface of
+// the node or one derived from the project, the node and the interface index
+func (t *NetemTopologyManager) getInterfaceMAC(node INetemNode, ifIndex int) net.HardwareAddr {
+	if nConfig := t.GetNodeConfig(node.GetName()); nConfig != nil {
+		ifConfig, found := nConfig.Interfaces[node.GetInterfaceName(ifIndex)]
+		if found && ifConfig.Mac != "" {
+			if mac, err := net.ParseMAC(ifConfig.Mac); err == nil {
+				return mac
+			}
+		}
+	}
+
+	// the id of the project changes each time it is open, unlike its
+	// name which is unique on the server
+	return link.GenerateMAC(fmt.Sprintf("%s/%s/%d", t.projectName(), node.GetName(), ifIndex))
+}
+
 func (t *NetemTopologyManager) setupLink(l *NetemLink, configure bool) error {
 	peer1Netns, err := l.Peer1.Node.GetNetns()
 	if err != nil {
@@ -706,7 +910,11 @@ func (t *NetemTopologyManager) setupLink(l *NetemLink, configure bool) error {
 
 	peer1IfName := l.Peer1.Node.GetInterfaceName(l.Peer1.IfIndex)
 	peer2IfName := l.Peer2.Node.GetInterfaceName(l.Peer2.IfIndex)
-	_, err = link.CreateVethLink(peer1IfName, peer1Netns, peer2IfName, peer2Netns)
+	_, err = link.CreateVethLink(peer1IfName, peer1Netns, peer2IfName, peer2Netns, link.VethOptions{
+		MTU:     l.Config.Mtu,
+		MAC:     t.getInterfaceMAC(l.Peer1.Node, l.Peer1.IfIndex),
+		PeerMAC: t.getInterfaceMAC(l.Peer2.Node, l.Peer2.IfIndex),
+	})
 	if err != nil {
 		return fmt.Errorf(
 			"unable to create link %s.%d-%s.%d: %v",
@@ -732,6 +940,11 @@ func (t *NetemTopologyManager) setupLink(l *NetemLink, configure bool) error {
 		return err
 	}
 
+	// set description as interface alias
+	if err := l.SetAlias(peer1IfName, peer1Netns, peer2IfName, peer2Netns); err != nil {
+		return err
+	}
+
 	// set interface up
 	if err := link.SetInterfaceState(peer1IfName, peer1Netns, link.IFSTATE_UP); err != nil {
 		return err
@@ -748,6 +961,16 @@ func (t *NetemTopologyManager) setupLink(l *NetemLink, configure bool) error {
 		return err
 	}
 
+	// apply declared interfaces configuration on running nodes
+	if configure {
+		if err := t.configureNodeNetwork(l.Peer1.Node); err != nil {
+			return err
+		}
+		if err := t.configureNodeNetwork(l.Peer2.Node); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -784,6 +1007,15 @@ func (t *NetemTopologyManager) GetLink(peer1V string, peer2V string) (*NetemLink
 }
 
 func (t *NetemTopologyManager) LinkAdd(linkCfg LinkConfig, sync bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if IsRemotePeer(linkCfg.Peer1) || IsRemotePeer(linkCfg.Peer2) {
+		return fmt.Errorf("remote links can only be declared in the topology")
+	}
+	if IsProjectPeer(linkCfg.Peer1) || IsProjectPeer(linkCfg.Peer2) {
+		return fmt.Errorf("project links can only be declared in the topology")
+	}
 	_, _, err := t.GetLink(linkCfg.Peer1, linkCfg.Peer2)
 	if err == nil {
 		return fmt.Errorf("this link already exist")
@@ -795,9 +1027,8 @@ func (t *NetemTopologyManager) LinkAdd(linkCfg LinkConfig, sync bool) error {
 	peer1Idx, _ := strconv.Atoi(peer1[1])
 	peer2Idx, _ := strconv.Atoi(peer2[1])
 
-	if linkCfg.Buffer == 0.0 {
-		// by default set limit buffer to 1.0 * BDP
-		linkCfg.Buffer = 1.0
+	if err := linkCfg.SetProfile(linkCfg.Profile, t.profiles); err != nil {
+		return err
 	}
 
 	link := &NetemLink{
@@ -828,10 +1059,22 @@ func (t *NetemTopologyManager) LinkAdd(linkCfg LinkConfig, sync bool) error {
 }
 
 func (t *NetemTopologyManager) LinkDel(linkCfg LinkConfig, sync bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if IsRemotePeer(linkCfg.Peer1) || IsRemotePeer(linkCfg.Peer2) {
+		return fmt.Errorf("remote links can only be declared in the topology")
+	}
+	if IsProjectPeer(linkCfg.Peer1) || IsProjectPeer(linkCfg.Peer2) {
+		return fmt.Errorf("project links can only be declared in the topology")
+	}
 	l, idx, err := t.GetLink(linkCfg.Peer1, linkCfg.Peer2)
 	if err != nil {
 		return err
 	}
+	if t.isLagMember(l) {
+		return fmt.Errorf("link %s-%s is a member of a lag", linkCfg.Peer1, linkCfg.Peer2)
+	}
 
 	peer1Netns, err := l.Peer1.Node.GetNetns()
 	if err != nil {
@@ -852,6 +1095,15 @@ func (t *NetemTopologyManager) LinkDel(linkCfg LinkConfig, sync bool) error {
 }
 
 func (t *NetemTopologyManager) LinkUpdate(linkCfg LinkConfig, sync bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if IsRemotePeer(linkCfg.Peer1) || IsRemotePeer(linkCfg.Peer2) {
+		return fmt.Errorf("remote links can only be declared in the topology")
+	}
+	if IsProjectPeer(linkCfg.Peer1) || IsProjectPeer(linkCfg.Peer2) {
+		return fmt.Errorf("project links can only be declared in the topology")
+	}
 	l, _, err := t.GetLink(linkCfg.Peer1, linkCfg.Peer2)
 	if err != nil {
 		return err
@@ -867,12 +1119,22 @@ func (t *NetemTopologyManager) LinkUpdate(linkCfg LinkConfig, sync bool) error {
 	}
 
 	// update config
+	if err := l.Config.UpdateQoS(linkCfg, t.profiles); err != nil {
+		return err
+	}
 	l.Config.Peer1QoS = linkCfg.Peer1QoS
 	l.Config.Peer2QoS = linkCfg.Peer2QoS
 
 	peer1IfName := l.Peer1.Node.GetInterfaceName(l.Peer1.IfIndex)
 	peer2IfName := l.Peer2.Node.GetInterfaceName(l.Peer2.IfIndex)
 
+	if linkCfg.Description != "" {
+		l.Config.Description = linkCfg.Description
+		if err := l.SetAlias(peer1IfName, peer1Netns, peer2IfName, peer2Netns); err != nil {
+			return err
+		}
+	}
+
 	// create/update netem qdisc if necessary
 	if err := l.SetPeer1Netem(peer1IfName, peer1Netns); err != nil {
 		return err
@@ -896,7 +1158,7 @@ func (t *NetemTopologyManager) LinkUpdate(linkCfg LinkConfig, sync bool) error {
 }
 
 func (t *NetemTopologyManager) IsRunning() bool {
-	return t.running
+	return t.running.Load()
 }
 
 func (t *NetemTopologyManager) GetNetFilePath() string {
@@ -911,6 +1173,10 @@ func (t *NetemTopologyManager) WriteNetworkFile(data []byte) error {
 	return os.WriteFile(t.GetNetFilePath(), data, 0644)
 }
 
+func (t *NetemTopologyManager) GetAddressingPlan() *AddressingPlan {
+	return t.plan
+}
+
 func (t *NetemTopologyManager) GetAllNodes() []INetemNode {
 	nodeInstances := make([]INetemNode, len(t.nodes))
 	for i := range t.nodes {
@@ -919,6 +1185,15 @@ func (t *NetemTopologyManager) GetAllNodes() []INetemNode {
 	return nodeInstances
 }
 
+func (t *NetemTopologyManager) GetNodeConfig(name string) *NodeConfig {
+	for idx := range t.nodes {
+		if t.nodes[idx].Instance.GetName() == name {
+			return &t.nodes[idx].Config
+		}
+	}
+	return nil
+}
+
 func (t *NetemTopologyManager) IsNodeLaunchAtStartup(name string) bool {
 	for _, node := range t.nodes {
 		if node.Instance.GetName() == name {
@@ -937,19 +1212,76 @@ func (t *NetemTopologyManager) GetNode(name string) INetemNode {
 	return nil
 }
 
+// GetNodeWaves returns the nodes sorted in startup waves according
+// to their dependencies, see ComputeStartWaves
+func (t *NetemTopologyManager) GetNodeWaves() [][]NetemNode {
+	configs := make(map[string]NodeConfig)
+	for _, node := range t.nodes {
+		configs[node.Instance.GetName()] = node.Config
+	}
+
+	waves, err := ComputeStartWaves(configs)
+	if err != nil {
+		// should not happen with a checked topology
+		t.logger.Warnf("Unable to compute startup order: %v", err)
+		return [][]NetemNode{t.nodes}
+	}
+
+	result := make([][]NetemNode, len(waves))
+	for idx, wave := range waves {
+		for _, name := range wave {
+			for _, node := range t.nodes {
+				if node.Instance.GetName() == name {
+					result[idx] = append(result[idx], node)
+					break
+				}
+			}
+		}
+	}
+	return result
+}
+
 func (t *NetemTopologyManager) startNode(node INetemNode) ([]string, error) {
+	crashed := false
+	if dNode, ok := node.(*docker.DockerNode); ok {
+		crashed = dNode.IsCrashed()
+	}
+
 	if err := node.Start(); err != nil {
 		return []string{}, fmt.Errorf("unable to start node %s: %w", node.GetName(), err)
 	}
 
-	configPath := path.Join(t.path, configDir)
+	if crashed {
+		if err := t.restoreNodeLinks(node); err != nil {
+			return []string{}, fmt.Errorf("unable to restore links of node %s: %w", node.GetName(), err)
+		}
+	}
+
+	var messages []string
 	timeout := options.ServerConfig.Docker.Timeoutop
-	messages, err := node.LoadConfig(configPath, timeout)
+	if err := node.WaitReady(timeout); err != nil {
+		t.logger.Warnf("Readiness probe failed: %v", err)
+		messages = append(messages, fmt.Sprintf("Readiness probe failed: %v", err))
+	}
+
+	if err := t.configureNodeLags(node); err != nil {
+		return messages, err
+	}
+	if err := t.configureNodeInterfaces(node); err != nil {
+		return messages, err
+	}
+	if err := t.restoreSnapshot(node); err != nil {
+		return messages, err
+	}
+
+	configPath := path.Join(t.path, configDir)
+	loadMessages, err := node.LoadConfig(configPath, timeout)
+	messages = append(messages, loadMessages...)
 	if err != nil {
 		return messages, fmt.Errorf("unable to load config of node %s: %w", node.GetName(), err)
 	}
 
-	return messages, nil
+	return messages, t.configureNodeRoutes(node)
 }
 
 func (t *NetemTopologyManager) stopNode(node INetemNode) error {
@@ -960,7 +1292,10 @@ func (t *NetemTopologyManager) stopNode(node INetemNode) error {
 }
 
 func (t *NetemTopologyManager) Start(nodeName string) ([]string, error) {
-	if !t.running {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.running.Load() {
 		t.logger.Warnf("Start %s: topology not running", nodeName)
 		return []string{}, nil
 	}
@@ -974,7 +1309,10 @@ func (t *NetemTopologyManager) Start(nodeName string) ([]string, error) {
 }
 
 func (t *NetemTopologyManager) Stop(nodeName string) error {
-	if !t.running {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.running.Load() {
 		t.logger.Warnf("Stop %s: topology not running", nodeName)
 		return nil
 	}
@@ -987,6 +1325,44 @@ func (t *NetemTopologyManager) Stop(nodeName string) error {
 	return t.stopNode(node)
 }
 
+func (t *NetemTopologyManager) Pause(nodeName string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.running.Load() {
+		return fmt.Errorf("topology not running")
+	}
+
+	node := t.GetNode(nodeName)
+	if node == nil {
+		return fmt.Errorf("node %s not found in the topology", nodeName)
+	}
+
+	if err := node.Pause(); err != nil {
+		return fmt.Errorf("unable to pause node %s: %w", nodeName, err)
+	}
+	return nil
+}
+
+func (t *NetemTopologyManager) Resume(nodeName string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.running.Load() {
+		return fmt.Errorf("topology not running")
+	}
+
+	node := t.GetNode(nodeName)
+	if node == nil {
+		return fmt.Errorf("node %s not found in the topology", nodeName)
+	}
+
+	if err := node.Resume(); err != nil {
+		return fmt.Errorf("unable to resume node %s: %w", nodeName, err)
+	}
+	return nil
+}
+
 func (t *NetemTopologyManager) ReadConfigFiles(nodeName string) (map[string][]byte, error) {
 	node := t.GetNode(nodeName)
 	if node == nil {
@@ -1025,28 +1401,59 @@ func (t *NetemTopologyManager) Save(progressCh chan TopologySaveProgressT) error
 			if node.Instance.IsRunning() {
 				err = node.Instance.Save(destPath, timeout)
 			}
+			if err != nil {
+				err = fmt.Errorf("node %s: save cmd error - %v", node.Instance.GetName(), err)
+			} else {
+				err = t.saveSnapshot(node)
+			}
 
 			if progressCh != nil {
 				progressCh <- TopologySaveProgressT{Code: NODE_SAVE}
 			}
 
-			if err != nil {
-				return fmt.Errorf("node %s: save cmd error - %v", node.Instance.GetName(), err)
-			}
-			return nil
+			return err
 		})
 	}
-	err := g.Wait()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// read back declared interfaces and routes
+	changed := false
+	for idx := range t.nodes {
+		nodeChanged, err := t.readNodeNetwork(&t.nodes[idx])
+		if err != nil {
+			return fmt.Errorf("node %s: unable to read network config - %v", t.nodes[idx].Instance.GetName(), err)
+		}
+		changed = changed || nodeChanged
+	}
+	if changed {
+		return t.SynchroniseTopology()
+	}
 
-	return err
+	return nil
 }
 
 func (t *NetemTopologyManager) Close(progressCh chan TopologyRunCloseProgressT) error {
+	// the watcher waits for restarts in progress, which take the lock
+	t.stopEventsWatcher()
+	t.closeSubscribers()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.closePortForwards()
+
 	if progressCh != nil {
 		progressCh <- TopologyRunCloseProgressT{Code: NODE_COUNT, Value: len(t.nodes)}
 		progressCh <- TopologyRunCloseProgressT{Code: BRIDGE_COUNT, Value: len(t.bridges)}
 	}
 
+	// restore host interfaces before the netns of nodes are destroyed
+	t.closePassthroughs()
+	t.closeRemoteLinks()
+	t.closeProjectLinks()
+
 	g := new(errgroup.Group)
 	g.SetLimit(maxConcurrentNodeTask)
 
@@ -1073,6 +1480,13 @@ func (t *NetemTopologyManager) Close(progressCh chan TopologyRunCloseProgressT)
 	rootNs := link.GetRootNetns()
 	defer rootNs.Close()
 	for _, br := range t.bridges {
+		if br.Config.Passthrough != "" {
+			if progressCh != nil {
+				progressCh <- TopologyRunCloseProgressT{Code: CLOSE_BRIDGE}
+			}
+			continue
+		}
+
 		for _, peer := range br.Peers {
 			ifName := fmt.Sprintf(
 				"%s%s%s.%d", options.NETEM_ID, t.prjID,
@@ -1092,9 +1506,18 @@ func (t *NetemTopologyManager) Close(progressCh chan TopologyRunCloseProgressT)
 		}
 	}
 
+	// close all segments
+	t.closeSegments()
+
 	t.nodes = make([]NetemNode, 0)
 	t.links = make([]*NetemLink, 0)
+	t.lags = make([]*NetemLag, 0)
 	t.bridges = make([]*NetemBridge, 0)
+	t.segments = make([]*NetemSegment, 0)
+	t.remoteLinksLock.Lock()
+	t.remoteLinks = make([]*NetemRemoteLink, 0)
+	t.remoteLinksLock.Unlock()
+	t.projectLinks = make([]*NetemProjectLink, 0)
 	t.IdGenerator.Close()
 
 	// close OVS instance
@@ -1116,10 +1539,13 @@ func (t *NetemTopologyManager) Close(progressCh chan TopologyRunCloseProgressT)
 
 func LoadTopology(prjID, prjPath string) (*NetemTopologyManager, error) {
 	topo := &NetemTopologyManager{
-		prjID:  prjID,
-		path:   prjPath,
-		nodes:  make([]NetemNode, 0),
-		logger: logrus.WithField("project", prjID),
+		prjID:        prjID,
+		path:         prjPath,
+		nodes:        make([]NetemNode, 0),
+		logger:       logrus.WithField("project", prjID),
+		subscribers:  make(map[chan NodeEvent]struct{}),
+		restarts:     make(map[string]int),
+		lastRestarts: make(map[string]time.Time),
 		IdGenerator: &NodeIdentifierGenerator{
 			lock: &sync.Mutex{},
 		},
@@ -0,0 +1,89 @@
+package topology
+
+import "fmt"
+
+// NoProfile is the profile name used to remove the profile of a link
+const NoProfile = "none"
+
+var (
+	// QoS presets available in every project, a profile with the same
+	// name declared in the topology takes precedence
+	builtinProfiles = map[string]QoSConfig{
+		"adsl": {
+			Delay:  25,
+			Jitter: 5,
+			Rate:   8192,
+			Loss:   0.1,
+		},
+		"4g": {
+			Delay:  50,
+			Jitter: 15,
+			Rate:   20480,
+			Loss:   0.5,
+		},
+		"geo-sat": {
+			Delay:  300,
+			Jitter: 10,
+			Rate:   10240,
+			Loss:   0.5,
+		},
+		"lossy-wifi": {
+			Delay:  5,
+			Jitter: 3,
+			Rate:   30720,
+			Loss:   5,
+		},
+	}
+)
+
+type QoSConfig struct {
+	Loss   float64 `yaml:",omitempty"` // percent
+	Delay  int     `yaml:",omitempty"` // ms
+	Jitter int     `yaml:",omitempty"` // ms
+	Rate   int     `yaml:",omitempty"` // kbps
+	Buffer float64 `yaml:",omitempty"` // BDP scale factor
+}
+
+func (q QoSConfig) IsEmpty() bool {
+	return q.Loss == 0 && q.Delay == 0 && q.Jitter == 0 && q.Rate == 0 && q.Buffer == 0
+}
+
+// WithDefaults returns q with the default value of the fields not set,
+// the limit buffer of tbf is 1.0 * BDP by default
+func (q QoSConfig) WithDefaults() QoSConfig {
+	if q.Buffer == 0.0 {
+		q.Buffer = 1.0
+	}
+	return q
+}
+
+// Merge returns q with every non-zero field of override applied on it
+func (q QoSConfig) Merge(override QoSConfig) QoSConfig {
+	if override.Loss != 0 {
+		q.Loss = override.Loss
+	}
+	if override.Delay != 0 {
+		q.Delay = override.Delay
+	}
+	if override.Jitter != 0 {
+		q.Jitter = override.Jitter
+	}
+	if override.Rate != 0 {
+		q.Rate = override.Rate
+	}
+	if override.Buffer != 0 {
+		q.Buffer = override.Buffer
+	}
+	return q
+}
+
+func GetQoSProfile(name string, profiles map[string]QoSConfig) (QoSConfig, error) {
+	if profile, found := profiles[name]; found {
+		return profile, nil
+	}
+	if profile, found := builtinProfiles[name]; found {
+		return profile, nil
+	}
+
+	return QoSConfig{}, fmt.Errorf("QoS profile '%s' not found", name)
+}
@@ -0,0 +1,104 @@
+package topology
+
+import (
+	"testing"
+)
+
+func TestQoSProfile_Get(t *testing.T) {
+	profiles := map[string]QoSConfig{
+		"wan": {Delay: 40, Rate: 10240},
+		"4g":  {Delay: 10},
+	}
+
+	if _, err := GetQoSProfile("unknown", profiles); err == nil {
+		t.Fatalf("An error is expected for an unknown profile")
+	}
+
+	qos, err := GetQoSProfile("adsl", profiles)
+	if err != nil {
+		t.Fatalf("Unable to get builtin profile adsl: %v", err)
+	}
+	if qos != builtinProfiles["adsl"] {
+		t.Fatalf("Unexpected value for profile adsl: %v", qos)
+	}
+
+	qos, err = GetQoSProfile("4g", profiles)
+	if err != nil {
+		t.Fatalf("Unable to get profile 4g: %v", err)
+	}
+	if qos.Delay != 10 {
+		t.Fatalf("Topology profile 4g does not override the builtin one: %v", qos)
+	}
+}
+
+func TestQoSProfile_LinkOverride(t *testing.T) {
+	profiles := map[string]QoSConfig{
+		"wan": {Delay: 40, Jitter: 5, Rate: 10240},
+	}
+
+	lConfig := LinkConfig{
+		Peer1:    "R1.0",
+		Peer2:    "R2.0",
+		Profile:  "wan",
+		Loss:     1,
+		Peer2QoS: QoSConfig{Delay: 100},
+	}
+	if err := lConfig.SetProfile(lConfig.Profile, profiles); err != nil {
+		t.Fatalf("Unable to set profile: %v", err)
+	}
+
+	expected := QoSConfig{Delay: 40, Jitter: 5, Rate: 10240, Loss: 1, Buffer: 1.0}
+	if qos := lConfig.GetPeer1QoS(); qos != expected {
+		t.Fatalf("Unexpected peer1 QoS: %v != %v", qos, expected)
+	}
+	// peer2qos overrides the profile, not the values of the link
+	expected = QoSConfig{Delay: 100, Jitter: 5, Rate: 10240, Buffer: 1.0}
+	if qos := lConfig.GetPeer2QoS(); qos != expected {
+		t.Fatalf("Unexpected peer2 QoS: %v != %v", qos, expected)
+	}
+}
+
+func TestQoSProfile_LinkUpdate(t *testing.T) {
+	profiles := map[string]QoSConfig{
+		"wan": {Delay: 40, Jitter: 5, Rate: 10240, Buffer: 2.0},
+	}
+
+	lConfig := LinkConfig{Peer1: "R1.0", Peer2: "R2.0", Delay: 10, Loss: 1}
+	if err := lConfig.UpdateQoS(LinkConfig{Loss: 2}, profiles); err != nil {
+		t.Fatalf("Unable to update link: %v", err)
+	}
+	// without profile, values of the update are applied on the link ones
+	expected := QoSConfig{Delay: 10, Loss: 2}
+	if qos := lConfig.GetQoS(); qos != expected {
+		t.Fatalf("Unexpected QoS: %v != %v", qos, expected)
+	}
+
+	// apply a profile on a link which already has values, only the
+	// values of the update override the profile
+	if err := lConfig.UpdateQoS(LinkConfig{Profile: "wan", Jitter: 2}, profiles); err != nil {
+		t.Fatalf("Unable to update link: %v", err)
+	}
+	expected = QoSConfig{Delay: 40, Jitter: 2, Rate: 10240, Buffer: 2.0}
+	if qos := lConfig.GetPeer1QoS(); qos != expected {
+		t.Fatalf("Unexpected QoS: %v != %v", qos, expected)
+	}
+
+	if err := lConfig.UpdateQoS(LinkConfig{Profile: "unknown"}, profiles); err == nil {
+		t.Fatalf("An error is expected for an unknown profile")
+	}
+	if lConfig.Profile != "wan" {
+		t.Fatalf("Link profile changed by a failed update: %s", lConfig.Profile)
+	}
+
+	// remove the profile, values of the link are kept
+	if err := lConfig.UpdateQoS(LinkConfig{Profile: NoProfile, Delay: 20}, profiles); err != nil {
+		t.Fatalf("Unable to remove profile: %v", err)
+	}
+	if lConfig.Profile != "" {
+		t.Fatalf("Link profile not removed: %s", lConfig.Profile)
+	}
+	expected = QoSConfig{Delay: 20, Jitter: 2, Buffer: 1.0}
+	if qos := lConfig.GetPeer2QoS(); qos != expected {
+		t.Fatalf("Unexpected QoS: %v != %v", qos, expected)
+	}
+}
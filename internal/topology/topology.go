@@ -0,0 +1,271 @@
+// Package topology declares the schema of the network.yml file of a
+// gonetem project. It is shared by the server and the console, so it
+// must not depend on the runtime packages (docker, link, ovs...)
+package topology
+
+import (
+	"github.com/creasty/defaults"
+	"github.com/mroy31/gonetem/internal/options"
+)
+
+const (
+	defaultP2PPrefix     = 30
+	defaultSegmentPrefix = 24
+)
+
+type NetemTopology struct {
+	Nodes      map[string]NodeConfig    `yaml:",omitempty"`
+	Links      []LinkConfig             `yaml:",omitempty"`
+	Bridges    map[string]BridgeConfig  `yaml:",omitempty"`
+	Lags       []LagConfig              `yaml:",omitempty"`
+	Segments   map[string]SegmentConfig `yaml:",omitempty"`
+	Profiles   map[string]QoSConfig     `yaml:",omitempty"`
+	Addressing AddressingConfig         `yaml:",omitempty"`
+	Mgntnet    MgntNetworkConfig        `yaml:",omitempty"`
+}
+
+type LinkConfig struct {
+	Peer1       string
+	Peer2       string
+	Description string  `yaml:",omitempty"`
+	Profile     string  `yaml:",omitempty"`
+	Loss        float64 `yaml:",omitempty"` // percent
+	Delay       int     `yaml:",omitempty"` // ms
+	Jitter      int     `yaml:",omitempty"` // ms
+	Rate        int     `yaml:",omitempty"` // kbps
+	Buffer      float64 `yaml:",omitempty"` // BDP scale factor
+	Mtu         int     `yaml:",omitempty"`
+	Peer1QoS    QoSConfig
+	Peer2QoS    QoSConfig
+
+	// QoS values of the profile, resolved when the link is loaded
+	profileQoS QoSConfig
+}
+
+// GetQoS returns the bidirectionnal QoS of the link, ie. the profile
+// values overridden by the ones set on the link itself
+func (l *LinkConfig) GetQoS() QoSConfig {
+	return l.profileQoS.Merge(QoSConfig{
+		Loss:   l.Loss,
+		Delay:  l.Delay,
+		Jitter: l.Jitter,
+		Rate:   l.Rate,
+		Buffer: l.Buffer,
+	})
+}
+
+// GetPeer1QoS returns the QoS applied in the direction peer1 --> peer2,
+// peer1qos values override the profile and the values set on the link
+func (l *LinkConfig) GetPeer1QoS() QoSConfig {
+	if !l.Peer1QoS.IsEmpty() {
+		return l.profileQoS.Merge(l.Peer1QoS).WithDefaults()
+	}
+
+	return l.GetQoS().WithDefaults()
+}
+
+func (l *LinkConfig) GetPeer2QoS() QoSConfig {
+	if !l.Peer2QoS.IsEmpty() {
+		return l.profileQoS.Merge(l.Peer2QoS).WithDefaults()
+	}
+
+	return l.GetQoS().WithDefaults()
+}
+
+// SetProfile resolves the QoS values of the profile name
+func (l *LinkConfig) SetProfile(name string, profiles map[string]QoSConfig) error {
+	l.Profile = name
+	l.profileQoS = QoSConfig{}
+	if name == "" || name == NoProfile {
+		l.Profile = ""
+		return nil
+	}
+
+	profile, err := GetQoSProfile(name, profiles)
+	if err != nil {
+		return err
+	}
+	l.profileQoS = profile
+
+	return nil
+}
+
+// UpdateQoS applies the profile and the bidirectionnal QoS of update on
+// the link. When update sets a profile, the QoS of the link is the profile
+// overridden by the values of update only. Otherwise, values of update are
+// applied on top of the ones of the link. The profile "none" removes the
+// profile of the link
+func (l *LinkConfig) UpdateQoS(update LinkConfig, profiles map[string]QoSConfig) error {
+	switch update.Profile {
+	case "":
+	case NoProfile:
+		l.SetProfile(NoProfile, profiles)
+	default:
+		// check the profile first to keep the link unchanged on error
+		if _, err := GetQoSProfile(update.Profile, profiles); err != nil {
+			return err
+		}
+		l.SetProfile(update.Profile, profiles)
+		l.Loss, l.Delay, l.Jitter, l.Rate, l.Buffer = 0, 0, 0, 0, 0
+	}
+
+	qos := QoSConfig{
+		Loss:   l.Loss,
+		Delay:  l.Delay,
+		Jitter: l.Jitter,
+		Rate:   l.Rate,
+		Buffer: l.Buffer,
+	}.Merge(QoSConfig{
+		Loss:   update.Loss,
+		Delay:  update.Delay,
+		Jitter: update.Jitter,
+		Rate:   update.Rate,
+		Buffer: update.Buffer,
+	})
+	l.Loss, l.Delay, l.Jitter, l.Rate, l.Buffer = qos.Loss, qos.Delay, qos.Jitter, qos.Rate, qos.Buffer
+
+	return nil
+}
+
+type BridgeConfig struct {
+	Host        string
+	Description string   `yaml:",omitempty"`
+	Interfaces  []string `yaml:",omitempty"`
+	Mtu         int      `yaml:",omitempty"`
+	Passthrough string   `yaml:",omitempty"` // direct, macvlan or ipvlan
+}
+
+type MgntNetworkConfig struct {
+	Enable  bool   `yaml:",omitempty" default:"false"`
+	Address string `yaml:",omitempty"`
+}
+type VrrpOptions struct {
+	Interface int
+	Group     int
+	Address   string
+}
+
+type MgntOptions struct {
+	Enable  bool   `yaml:",omitempty" default:"false"`
+	Address string `yaml:",omitempty"`
+}
+
+// NodePosition stores the coordinates of a node in a diagram
+type NodePosition struct {
+	X float64
+	Y float64
+}
+
+type NodeConfig struct {
+	Type        string
+	IPv6        bool                        `yaml:",omitempty" default:"true"`
+	Mpls        bool                        `yaml:",omitempty" default:"false"`
+	Vrfs        []string                    `yaml:",omitempty"`
+	Vrrps       []VrrpOptions               `yaml:",omitempty"`
+	Volumes     []string                    `yaml:",omitempty"`
+	Image       string                      `yaml:",omitempty"`
+	Launch      bool                        `default:"true"`
+	Mgnt        MgntOptions                 `yaml:",omitempty"`
+	Position    *NodePosition               `yaml:",omitempty"`
+	Description string                      `yaml:",omitempty"`
+	Icon        string                      `yaml:",omitempty"`
+	Labels      map[string]string           `yaml:",omitempty"`
+	Resources   options.DockerNodeResources `yaml:",omitempty"`
+	Restart     string                      `yaml:",omitempty"`
+	Persist     string                      `yaml:",omitempty"`
+	Sysctls     map[string]string           `yaml:",omitempty"`
+	Interfaces  map[string]InterfaceConfig  `yaml:",omitempty"`
+	Routes      []RouteConfig               `yaml:",omitempty"`
+	Ports       []string                    `yaml:",omitempty"`
+	DependsOn   []string                    `yaml:"dependsOn,omitempty"`
+}
+
+func (n *NodeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	defaults.Set(n)
+
+	type plain NodeConfig
+	if err := unmarshal((*plain)(n)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InterfaceConfig is the declarative configuration of a node interface,
+// applied by the server whatever the image of the node
+type InterfaceConfig struct {
+	Addresses   []string     `yaml:",omitempty"`
+	Mtu         int          `yaml:",omitempty"`
+	Mac         string       `yaml:",omitempty"`
+	Description string       `yaml:",omitempty"`
+	Vlans       []VlanConfig `yaml:",omitempty"`
+}
+
+// VlanConfig is a 802.1Q sub-interface created on top of a node
+// interface, named <interface>.<id>
+type VlanConfig struct {
+	Id        int
+	Addresses []string `yaml:",omitempty"`
+}
+
+// RouteConfig is a static route of a node, Destination is a prefix
+// or "default"
+type RouteConfig struct {
+	Destination string
+	Gateway     string `yaml:",omitempty"`
+	Device      string `yaml:",omitempty"`
+}
+
+// LagConfig is a link aggregation between two nodes. Each member link is
+// a standard link, members are aggregated in a bond named Name on docker
+// nodes and in a bond port named <switch>.<Name> on switches
+type LagConfig struct {
+	Name     string
+	Mode     string       `yaml:",omitempty"`         // 802.3ad (default), active-backup or balance-xor
+	LacpRate string       `yaml:"lacpRate,omitempty"` // slow (default) or fast
+	Links    []LinkConfig `yaml:",omitempty"`
+}
+
+// SegmentMember is a node interface connected to a segment, the QoS
+// is applied on the traffic sent by the segment to this interface
+type SegmentMember struct {
+	Peer      string
+	QoSConfig `yaml:",inline"`
+}
+
+// SegmentConfig is a multi-access LAN, emulated with a Linux bridge
+// in a netns dedicated to the segments of the project
+type SegmentConfig struct {
+	Description string `yaml:",omitempty"`
+	Mtu         int    `yaml:",omitempty"`
+	Members     []SegmentMember
+}
+
+// AddressingConfig describes the pools used to generate automatically
+// the IP addressing plan of the topology
+type AddressingConfig struct {
+	IPv4          string `yaml:",omitempty"`
+	IPv6          string `yaml:",omitempty"`
+	P2PPrefix     int    `yaml:",omitempty"` // 30 or 31
+	SegmentPrefix int    `yaml:",omitempty"` // IPv4 prefix of switch segments
+}
+
+func (a AddressingConfig) IsEnabled() bool {
+	return a.IPv4 != "" || a.IPv6 != ""
+}
+
+// GetP2PPrefix returns the IPv4 prefix length of point-to-point links
+func (a AddressingConfig) GetP2PPrefix() int {
+	if a.P2PPrefix == 0 {
+		return defaultP2PPrefix
+	}
+	return a.P2PPrefix
+}
+
+// GetSegmentPrefix returns the IPv4 prefix length of segments
+func (a AddressingConfig) GetSegmentPrefix() int {
+	if a.SegmentPrefix == 0 {
+		return defaultSegmentPrefix
+	}
+	return a.SegmentPrefix
+}
@@ -98,6 +98,30 @@ func CreateArchive(sourcePath string, w io.Writer) error {
 	return nil
 }
 
+// CreateOverlayArchive creates an uncompressed tar archive with the
+// regular files found in sourcePath, to be extracted at the root of a
+// container. Directories are not included so the mode of existing ones
+// in the container are kept.
+func CreateOverlayArchive(sourcePath string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	defer tw.Close()
+
+	return filepath.Walk(sourcePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourcePath, path)
+		if err != nil {
+			return err
+		}
+		return AddFileToTar(tw, path, relPath)
+	})
+}
+
 func OpenArchive(dstPath string, r io.Reader) error {
 	// check the format of data (tar.gz)
 	gzf, err := gzip.NewReader(r)
@@ -130,12 +154,18 @@ func OpenArchive(dstPath string, r io.Reader) error {
 				}
 			}
 		case tar.TypeReg: // = regular file
-			outFile, err := os.Create(path.Join(dstPath, header.Name))
+			target := path.Join(dstPath, header.Name)
+			outFile, err := os.Create(target)
 			if err != nil {
 				return fmt.Errorf("Unable to create file '%s': %w", name, err)
 			}
 			defer outFile.Close()
 
+			// keep file mode, ie. for scripts of files overlays
+			if err := os.Chmod(target, os.FileMode(header.Mode).Perm()); err != nil {
+				return fmt.Errorf("Unable to set mode of file '%s': %w", name, err)
+			}
+
 			if _, err := io.Copy(outFile, tarReader); err != nil {
 				return fmt.Errorf("Unable to copy file '%s': %w", name, err)
 			}